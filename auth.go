@@ -1,32 +1,22 @@
 package vrchat
 
 import (
-	"fmt"
+	"context"
 )
 
-// Authenticate authenticates the client with the VRChat API
+// Authenticate calls AuthenticateWithContext with context.Background().
 func (c *Client) Authenticate(username, password, totp string) error {
-	c.client.SetHeaders(map[string]string{
-		"User-Agent":   "vrchat-go/0.0.0 mayo@linux.com",
-		"Accept":       "application/json",
-		"Content-Type": "application/json",
-	})
-	resp, err := c.client.R().
-		SetBasicAuth(username, password).
-		SetBody(map[string]string{
-			"code": totp,
-		}).
-		Post("/auth/twofactorauth/totp/verify")
-	if err != nil {
-		return err
-	}
+	return c.AuthenticateWithContext(context.Background(), username, password, totp)
+}
 
-	if resp.StatusCode() != 200 {
-		return fmt.Errorf("failed to authenticate: %s", resp.String())
+// AuthenticateWithContext authenticates the client with the VRChat API. totp
+// is only used if the account requires two-factor authentication; use
+// LoginWithCodeProvider for other second factors.
+func (c *Client) AuthenticateWithContext(ctx context.Context, username, password, totp string) error {
+	var codes CodeProvider
+	if totp != "" {
+		codes = StaticCodeProvider(TwoFactorTOTP, totp)
 	}
-
-	cookies := resp.Cookies()
-	c.client.SetCookies(cookies)
-
-	return nil
+	_, err := c.LoginWithCodeProvider(ctx, username, password, codes)
+	return err
 }
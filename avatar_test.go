@@ -0,0 +1,38 @@
+package vrchat
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestAvatarRequestBodies(t *testing.T) {
+	runRequestTests(t, []requestTest{
+		{
+			name: "CreateAvatar",
+			call: func(c *Client) error {
+				_, err := c.CreateAvatar(CreateAvatarRequest{
+					Name:          "My Avatar",
+					ImageUrl:      "https://example.com/image.png",
+					ReleaseStatus: Ptr(ReleaseStatusPrivate),
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/avatars",
+			body:   `{"name":"My Avatar","imageUrl":"https://example.com/image.png","releaseStatus":"private"}`,
+		},
+		{
+			name: "UpdateAvatar",
+			call: func(c *Client) error {
+				_, err := c.UpdateAvatar(UpdateAvatarParams{AvatarId: "avtr_1"}, UpdateAvatarRequest{
+					Description: Ptr(""),
+					Tags:        Ptr([]Tag{}),
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/avatars/avtr_1",
+			body:   `{"description":"","tags":[]}`,
+		},
+	})
+}
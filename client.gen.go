@@ -1,51 +1,47 @@
 package vrchat
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/go-resty/resty/v2"
-	"github.com/samber/lo"
 )
 
-type Client struct {
-	client *resty.Client
-}
-
-func NewClient(baseURL string) *Client {
-	return &Client{
-		client: resty.New().SetBaseURL(baseURL),
-	}
-}
-
 // CheckUserExistsParams represents the parameters for the CheckUserExists request
 type CheckUserExistsParams struct {
-	Email         string `json:"email"`
-	DisplayName   string `json:"displayName"`
-	Username      string `json:"username"`
-	ExcludeUserId string `json:"excludeUserId"`
+	Email         *string `json:"email"`
+	DisplayName   *string `json:"displayName"`
+	Username      *string `json:"username"`
+	ExcludeUserId *string `json:"excludeUserId"`
 }
 
+// CheckUserExists calls CheckUserExistsWithContext with context.Background().
 func (c *Client) CheckUserExists(params CheckUserExistsParams) (*UserExistsResponse, error) {
+	return c.CheckUserExistsWithContext(context.Background(), params)
+}
+
+func (c *Client) CheckUserExistsWithContext(ctx context.Context, params CheckUserExistsParams) (*UserExistsResponse, error) {
 	path := "/auth/exists"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Email) {
-		queryParams["email"] = fmt.Sprintf("%v", params.Email)
+	if params.Email != nil {
+		queryParams["email"] = fmt.Sprintf("%v", *params.Email)
 	}
-	if lo.IsNotEmpty(params.DisplayName) {
-		queryParams["displayName"] = fmt.Sprintf("%v", params.DisplayName)
+	if params.DisplayName != nil {
+		queryParams["displayName"] = fmt.Sprintf("%v", *params.DisplayName)
 	}
-	if lo.IsNotEmpty(params.Username) {
-		queryParams["username"] = fmt.Sprintf("%v", params.Username)
+	if params.Username != nil {
+		queryParams["username"] = fmt.Sprintf("%v", *params.Username)
 	}
-	if lo.IsNotEmpty(params.ExcludeUserId) {
-		queryParams["excludeUserId"] = fmt.Sprintf("%v", params.ExcludeUserId)
+	if params.ExcludeUserId != nil {
+		queryParams["excludeUserId"] = fmt.Sprintf("%v", *params.ExcludeUserId)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -60,16 +56,21 @@ func (c *Client) CheckUserExists(params CheckUserExistsParams) (*UserExistsRespo
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("checkUserExists", resp)
 	}
 	return &result, nil
 }
 
+// GetCurrentUser calls GetCurrentUserWithContext with context.Background().
 func (c *Client) GetCurrentUser() (*CurrentUserLoginResponse, error) {
+	return c.GetCurrentUserWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentUserWithContext(ctx context.Context) (*CurrentUserLoginResponse, error) {
 	path := "/auth/user"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result CurrentUserLoginResponse
 	req.SetResult(&result)
@@ -82,16 +83,23 @@ func (c *Client) GetCurrentUser() (*CurrentUserLoginResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getCurrentUser", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) Verify2Fa() (*Verify2FaResponse, error) {
+// Verify2Fa calls Verify2FaWithContext with context.Background().
+func (c *Client) Verify2Fa(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
+	return c.Verify2FaWithContext(context.Background(), body)
+}
+
+func (c *Client) Verify2FaWithContext(ctx context.Context, body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	path := "/auth/twofactorauth/totp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result Verify2FaResponse
 	req.SetResult(&result)
@@ -104,16 +112,23 @@ func (c *Client) Verify2Fa() (*Verify2FaResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("verify2FA", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) VerifyRecoveryCode() (*Verify2FaResponse, error) {
+// VerifyRecoveryCode calls VerifyRecoveryCodeWithContext with context.Background().
+func (c *Client) VerifyRecoveryCode(body TwoFactorAuthCode) (*Verify2FaResponse, error) {
+	return c.VerifyRecoveryCodeWithContext(context.Background(), body)
+}
+
+func (c *Client) VerifyRecoveryCodeWithContext(ctx context.Context, body TwoFactorAuthCode) (*Verify2FaResponse, error) {
 	path := "/auth/twofactorauth/otp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result Verify2FaResponse
 	req.SetResult(&result)
@@ -126,16 +141,23 @@ func (c *Client) VerifyRecoveryCode() (*Verify2FaResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("verifyRecoveryCode", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) Verify2FaEmailCode() (*Verify2FaEmailCodeResponse, error) {
+// Verify2FaEmailCode calls Verify2FaEmailCodeWithContext with context.Background().
+func (c *Client) Verify2FaEmailCode(body TwoFactorEmailCode) (*Verify2FaEmailCodeResponse, error) {
+	return c.Verify2FaEmailCodeWithContext(context.Background(), body)
+}
+
+func (c *Client) Verify2FaEmailCodeWithContext(ctx context.Context, body TwoFactorEmailCode) (*Verify2FaEmailCodeResponse, error) {
 	path := "/auth/twofactorauth/emailotp/verify"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result Verify2FaEmailCodeResponse
 	req.SetResult(&result)
@@ -148,16 +170,21 @@ func (c *Client) Verify2FaEmailCode() (*Verify2FaEmailCodeResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("verify2FAEmailCode", resp)
 	}
 	return &result, nil
 }
 
+// VerifyAuthToken calls VerifyAuthTokenWithContext with context.Background().
 func (c *Client) VerifyAuthToken() (*VerifyAuthTokenResponse, error) {
+	return c.VerifyAuthTokenWithContext(context.Background())
+}
+
+func (c *Client) VerifyAuthTokenWithContext(ctx context.Context) (*VerifyAuthTokenResponse, error) {
 	path := "/auth"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result VerifyAuthTokenResponse
 	req.SetResult(&result)
@@ -170,16 +197,21 @@ func (c *Client) VerifyAuthToken() (*VerifyAuthTokenResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("verifyAuthToken", resp)
 	}
 	return &result, nil
 }
 
+// Logout calls LogoutWithContext with context.Background().
 func (c *Client) Logout() (*LogoutSuccess, error) {
+	return c.LogoutWithContext(context.Background())
+}
+
+func (c *Client) LogoutWithContext(ctx context.Context) (*LogoutSuccess, error) {
 	path := "/logout"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result LogoutSuccess
 	req.SetResult(&result)
@@ -192,7 +224,7 @@ func (c *Client) Logout() (*LogoutSuccess, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("logout", resp)
 	}
 	return &result, nil
 }
@@ -202,14 +234,19 @@ type DeleteUserParams struct {
 	UserId string `json:"userId"`
 }
 
+// DeleteUser calls DeleteUserWithContext with context.Background().
 func (c *Client) DeleteUser(params DeleteUserParams) (*DeleteUserResponse, error) {
+	return c.DeleteUserWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteUserWithContext(ctx context.Context, params DeleteUserParams) (*DeleteUserResponse, error) {
 	path := "/users/{userId}/delete"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -224,7 +261,7 @@ func (c *Client) DeleteUser(params DeleteUserParams) (*DeleteUserResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteUser", resp)
 	}
 	return &result, nil
 }
@@ -234,14 +271,19 @@ type GetOwnAvatarParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetOwnAvatar calls GetOwnAvatarWithContext with context.Background().
 func (c *Client) GetOwnAvatar(params GetOwnAvatarParams) (*AvatarResponse, error) {
+	return c.GetOwnAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) GetOwnAvatarWithContext(ctx context.Context, params GetOwnAvatarParams) (*AvatarResponse, error) {
 	path := "/users/{userId}/avatar"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -256,16 +298,23 @@ func (c *Client) GetOwnAvatar(params GetOwnAvatarParams) (*AvatarResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getOwnAvatar", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateAvatar() (*AvatarResponse, error) {
+// CreateAvatar calls CreateAvatarWithContext with context.Background().
+func (c *Client) CreateAvatar(body CreateAvatarRequest) (*AvatarResponse, error) {
+	return c.CreateAvatarWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateAvatarWithContext(ctx context.Context, body CreateAvatarRequest) (*AvatarResponse, error) {
 	path := "/avatars"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result AvatarResponse
 	req.SetResult(&result)
@@ -278,70 +327,75 @@ func (c *Client) CreateAvatar() (*AvatarResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createAvatar", resp)
 	}
 	return &result, nil
 }
 
 // SearchAvatarsParams represents the parameters for the SearchAvatars request
 type SearchAvatarsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	UserId          string        `json:"userId"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	UserId          *string        `json:"userId"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+}
+
+// SearchAvatars calls SearchAvatarsWithContext with context.Background().
+func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse, error) {
+	return c.SearchAvatarsWithContext(context.Background(), params)
 }
 
-func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse, error) {
+func (c *Client) SearchAvatarsWithContext(ctx context.Context, params SearchAvatarsParams) (*AvatarListResponse, error) {
 	path := "/avatars"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.UserId) {
-		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
+	if params.UserId != nil {
+		queryParams["userId"] = fmt.Sprintf("%v", *params.UserId)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -356,7 +410,7 @@ func (c *Client) SearchAvatars(params SearchAvatarsParams) (*AvatarListResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("searchAvatars", resp)
 	}
 	return &result, nil
 }
@@ -366,14 +420,19 @@ type DeleteAvatarParams struct {
 	AvatarId string `json:"avatarId"`
 }
 
+// DeleteAvatar calls DeleteAvatarWithContext with context.Background().
 func (c *Client) DeleteAvatar(params DeleteAvatarParams) (*AvatarResponse, error) {
+	return c.DeleteAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteAvatarWithContext(ctx context.Context, params DeleteAvatarParams) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
+	path = strings.ReplaceAll(path, "{avatarId}", pathParam(params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -388,7 +447,7 @@ func (c *Client) DeleteAvatar(params DeleteAvatarParams) (*AvatarResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteAvatar", resp)
 	}
 	return &result, nil
 }
@@ -398,14 +457,19 @@ type GetAvatarParams struct {
 	AvatarId string `json:"avatarId"`
 }
 
+// GetAvatar calls GetAvatarWithContext with context.Background().
 func (c *Client) GetAvatar(params GetAvatarParams) (*AvatarResponse, error) {
+	return c.GetAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) GetAvatarWithContext(ctx context.Context, params GetAvatarParams) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
+	path = strings.ReplaceAll(path, "{avatarId}", pathParam(params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -420,7 +484,7 @@ func (c *Client) GetAvatar(params GetAvatarParams) (*AvatarResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getAvatar", resp)
 	}
 	return &result, nil
 }
@@ -430,16 +494,23 @@ type UpdateAvatarParams struct {
 	AvatarId string `json:"avatarId"`
 }
 
-func (c *Client) UpdateAvatar(params UpdateAvatarParams) (*AvatarResponse, error) {
+// UpdateAvatar calls UpdateAvatarWithContext with context.Background().
+func (c *Client) UpdateAvatar(params UpdateAvatarParams, body UpdateAvatarRequest) (*AvatarResponse, error) {
+	return c.UpdateAvatarWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateAvatarWithContext(ctx context.Context, params UpdateAvatarParams, body UpdateAvatarRequest) (*AvatarResponse, error) {
 	path := "/avatars/{avatarId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
+	path = strings.ReplaceAll(path, "{avatarId}", pathParam(params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result AvatarResponse
 	req.SetResult(&result)
@@ -452,7 +523,7 @@ func (c *Client) UpdateAvatar(params UpdateAvatarParams) (*AvatarResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateAvatar", resp)
 	}
 	return &result, nil
 }
@@ -462,14 +533,19 @@ type SelectAvatarParams struct {
 	AvatarId string `json:"avatarId"`
 }
 
+// SelectAvatar calls SelectAvatarWithContext with context.Background().
 func (c *Client) SelectAvatar(params SelectAvatarParams) (*CurrentUserResponse, error) {
+	return c.SelectAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) SelectAvatarWithContext(ctx context.Context, params SelectAvatarParams) (*CurrentUserResponse, error) {
 	path := "/avatars/{avatarId}/select"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
+	path = strings.ReplaceAll(path, "{avatarId}", pathParam(params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -484,7 +560,7 @@ func (c *Client) SelectAvatar(params SelectAvatarParams) (*CurrentUserResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("selectAvatar", resp)
 	}
 	return &result, nil
 }
@@ -494,14 +570,19 @@ type SelectFallbackAvatarParams struct {
 	AvatarId string `json:"avatarId"`
 }
 
+// SelectFallbackAvatar calls SelectFallbackAvatarWithContext with context.Background().
 func (c *Client) SelectFallbackAvatar(params SelectFallbackAvatarParams) (*CurrentUserResponse, error) {
+	return c.SelectFallbackAvatarWithContext(context.Background(), params)
+}
+
+func (c *Client) SelectFallbackAvatarWithContext(ctx context.Context, params SelectFallbackAvatarParams) (*CurrentUserResponse, error) {
 	path := "/avatars/{avatarId}/selectFallback"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{avatarId}", fmt.Sprintf("%v", params.AvatarId))
+	path = strings.ReplaceAll(path, "{avatarId}", pathParam(params.AvatarId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -516,74 +597,79 @@ func (c *Client) SelectFallbackAvatar(params SelectFallbackAvatarParams) (*Curre
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("selectFallbackAvatar", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritedAvatarsParams represents the parameters for the GetFavoritedAvatars request
 type GetFavoritedAvatarsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
-	UserId          string        `json:"userId"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Search          *string        `json:"search"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+	UserId          *string        `json:"userId"`
+}
+
+// GetFavoritedAvatars calls GetFavoritedAvatarsWithContext with context.Background().
+func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarListResponse, error) {
+	return c.GetFavoritedAvatarsWithContext(context.Background(), params)
 }
 
-func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarListResponse, error) {
+func (c *Client) GetFavoritedAvatarsWithContext(ctx context.Context, params GetFavoritedAvatarsParams) (*AvatarListResponse, error) {
 	path := "/avatars/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Search) {
-		queryParams["search"] = fmt.Sprintf("%v", params.Search)
+	if params.Search != nil {
+		queryParams["search"] = fmt.Sprintf("%v", *params.Search)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
-	if lo.IsNotEmpty(params.UserId) {
-		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
+	if params.UserId != nil {
+		queryParams["userId"] = fmt.Sprintf("%v", *params.UserId)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -598,16 +684,21 @@ func (c *Client) GetFavoritedAvatars(params GetFavoritedAvatarsParams) (*AvatarL
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavoritedAvatars", resp)
 	}
 	return &result, nil
 }
 
+// GetSteamTransactions calls GetSteamTransactionsWithContext with context.Background().
 func (c *Client) GetSteamTransactions() (*TransactionListResponse, error) {
+	return c.GetSteamTransactionsWithContext(context.Background())
+}
+
+func (c *Client) GetSteamTransactionsWithContext(ctx context.Context) (*TransactionListResponse, error) {
 	path := "/Steam/transactions"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result TransactionListResponse
 	req.SetResult(&result)
@@ -620,7 +711,7 @@ func (c *Client) GetSteamTransactions() (*TransactionListResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getSteamTransactions", resp)
 	}
 	return &result, nil
 }
@@ -630,14 +721,19 @@ type GetSteamTransactionParams struct {
 	TransactionId string `json:"transactionId"`
 }
 
+// GetSteamTransaction calls GetSteamTransactionWithContext with context.Background().
 func (c *Client) GetSteamTransaction(params GetSteamTransactionParams) (*TransactionResponse, error) {
+	return c.GetSteamTransactionWithContext(context.Background(), params)
+}
+
+func (c *Client) GetSteamTransactionWithContext(ctx context.Context, params GetSteamTransactionParams) (*TransactionResponse, error) {
 	path := "/Steam/transactions/{transactionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{transactionId}", fmt.Sprintf("%v", params.TransactionId))
+	path = strings.ReplaceAll(path, "{transactionId}", pathParam(params.TransactionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -652,16 +748,21 @@ func (c *Client) GetSteamTransaction(params GetSteamTransactionParams) (*Transac
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getSteamTransaction", resp)
 	}
 	return &result, nil
 }
 
+// GetCurrentSubscriptions calls GetCurrentSubscriptionsWithContext with context.Background().
 func (c *Client) GetCurrentSubscriptions() (*UserSubscriptionListResponse, error) {
+	return c.GetCurrentSubscriptionsWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentSubscriptionsWithContext(ctx context.Context) (*UserSubscriptionListResponse, error) {
 	path := "/auth/user/subscription"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result UserSubscriptionListResponse
 	req.SetResult(&result)
@@ -674,16 +775,21 @@ func (c *Client) GetCurrentSubscriptions() (*UserSubscriptionListResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getCurrentSubscriptions", resp)
 	}
 	return &result, nil
 }
 
+// GetSubscriptions calls GetSubscriptionsWithContext with context.Background().
 func (c *Client) GetSubscriptions() (*SubscriptionListResponse, error) {
+	return c.GetSubscriptionsWithContext(context.Background())
+}
+
+func (c *Client) GetSubscriptionsWithContext(ctx context.Context) (*SubscriptionListResponse, error) {
 	path := "/subscriptions"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result SubscriptionListResponse
 	req.SetResult(&result)
@@ -696,7 +802,7 @@ func (c *Client) GetSubscriptions() (*SubscriptionListResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getSubscriptions", resp)
 	}
 	return &result, nil
 }
@@ -706,14 +812,19 @@ type GetLicenseGroupParams struct {
 	LicenseGroupId string `json:"licenseGroupId"`
 }
 
+// GetLicenseGroup calls GetLicenseGroupWithContext with context.Background().
 func (c *Client) GetLicenseGroup(params GetLicenseGroupParams) (*LicenseGroupResponse, error) {
+	return c.GetLicenseGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetLicenseGroupWithContext(ctx context.Context, params GetLicenseGroupParams) (*LicenseGroupResponse, error) {
 	path := "/licenseGroups/{licenseGroupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{licenseGroupId}", fmt.Sprintf("%v", params.LicenseGroupId))
+	path = strings.ReplaceAll(path, "{licenseGroupId}", pathParam(params.LicenseGroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -728,34 +839,39 @@ func (c *Client) GetLicenseGroup(params GetLicenseGroupParams) (*LicenseGroupRes
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getLicenseGroup", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritesParams represents the parameters for the GetFavorites request
 type GetFavoritesParams struct {
-	N      int64  `json:"n"`
-	Offset int64  `json:"offset"`
-	Tag    string `json:"tag"`
+	N      *int64  `json:"n"`
+	Offset *int64  `json:"offset"`
+	Tag    *string `json:"tag"`
 }
 
+// GetFavorites calls GetFavoritesWithContext with context.Background().
 func (c *Client) GetFavorites(params GetFavoritesParams) (*FavoriteListResponse, error) {
+	return c.GetFavoritesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoritesWithContext(ctx context.Context, params GetFavoritesParams) (*FavoriteListResponse, error) {
 	path := "/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -770,16 +886,23 @@ func (c *Client) GetFavorites(params GetFavoritesParams) (*FavoriteListResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavorites", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) AddFavorite() (*FavoriteResponse, error) {
+// AddFavorite calls AddFavoriteWithContext with context.Background().
+func (c *Client) AddFavorite(body AddFavoriteRequest) (*FavoriteResponse, error) {
+	return c.AddFavoriteWithContext(context.Background(), body)
+}
+
+func (c *Client) AddFavoriteWithContext(ctx context.Context, body AddFavoriteRequest) (*FavoriteResponse, error) {
 	path := "/favorites"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result FavoriteResponse
 	req.SetResult(&result)
@@ -792,7 +915,7 @@ func (c *Client) AddFavorite() (*FavoriteResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("addFavorite", resp)
 	}
 	return &result, nil
 }
@@ -802,14 +925,19 @@ type RemoveFavoriteParams struct {
 	FavoriteId string `json:"favoriteId"`
 }
 
+// RemoveFavorite calls RemoveFavoriteWithContext with context.Background().
 func (c *Client) RemoveFavorite(params RemoveFavoriteParams) (*FavoriteRemovedSuccess, error) {
+	return c.RemoveFavoriteWithContext(context.Background(), params)
+}
+
+func (c *Client) RemoveFavoriteWithContext(ctx context.Context, params RemoveFavoriteParams) (*FavoriteRemovedSuccess, error) {
 	path := "/favorites/{favoriteId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{favoriteId}", fmt.Sprintf("%v", params.FavoriteId))
+	path = strings.ReplaceAll(path, "{favoriteId}", pathParam(params.FavoriteId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -824,7 +952,7 @@ func (c *Client) RemoveFavorite(params RemoveFavoriteParams) (*FavoriteRemovedSu
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("removeFavorite", resp)
 	}
 	return &result, nil
 }
@@ -834,14 +962,19 @@ type GetFavoriteParams struct {
 	FavoriteId string `json:"favoriteId"`
 }
 
+// GetFavorite calls GetFavoriteWithContext with context.Background().
 func (c *Client) GetFavorite(params GetFavoriteParams) (*FavoriteResponse, error) {
+	return c.GetFavoriteWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoriteWithContext(ctx context.Context, params GetFavoriteParams) (*FavoriteResponse, error) {
 	path := "/favorites/{favoriteId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{favoriteId}", fmt.Sprintf("%v", params.FavoriteId))
+	path = strings.ReplaceAll(path, "{favoriteId}", pathParam(params.FavoriteId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -856,30 +989,35 @@ func (c *Client) GetFavorite(params GetFavoriteParams) (*FavoriteResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavorite", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoriteGroupsParams represents the parameters for the GetFavoriteGroups request
 type GetFavoriteGroupsParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      *int64 `json:"n"`
+	Offset *int64 `json:"offset"`
 }
 
+// GetFavoriteGroups calls GetFavoriteGroupsWithContext with context.Background().
 func (c *Client) GetFavoriteGroups(params GetFavoriteGroupsParams) (*FavoriteGroupListResponse, error) {
+	return c.GetFavoriteGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoriteGroupsWithContext(ctx context.Context, params GetFavoriteGroupsParams) (*FavoriteGroupListResponse, error) {
 	path := "/favorite/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -894,7 +1032,7 @@ func (c *Client) GetFavoriteGroups(params GetFavoriteGroupsParams) (*FavoriteGro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavoriteGroups", resp)
 	}
 	return &result, nil
 }
@@ -907,16 +1045,21 @@ type ClearFavoriteGroupParams struct {
 	UserId            string `json:"userId"`
 }
 
+// ClearFavoriteGroup calls ClearFavoriteGroupWithContext with context.Background().
 func (c *Client) ClearFavoriteGroup(params ClearFavoriteGroupParams) (*FavoriteGroupClearedSuccess, error) {
+	return c.ClearFavoriteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) ClearFavoriteGroupWithContext(ctx context.Context, params ClearFavoriteGroupParams) (*FavoriteGroupClearedSuccess, error) {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{favoriteGroupType}", fmt.Sprintf("%v", params.FavoriteGroupType))
-	path = strings.ReplaceAll(path, "{favoriteGroupName}", fmt.Sprintf("%v", params.FavoriteGroupName))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{favoriteGroupType}", pathParam(params.FavoriteGroupType))
+	path = strings.ReplaceAll(path, "{favoriteGroupName}", pathParam(params.FavoriteGroupName))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -931,7 +1074,7 @@ func (c *Client) ClearFavoriteGroup(params ClearFavoriteGroupParams) (*FavoriteG
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("clearFavoriteGroup", resp)
 	}
 	return &result, nil
 }
@@ -944,16 +1087,21 @@ type GetFavoriteGroupParams struct {
 	UserId            string `json:"userId"`
 }
 
+// GetFavoriteGroup calls GetFavoriteGroupWithContext with context.Background().
 func (c *Client) GetFavoriteGroup(params GetFavoriteGroupParams) (*FavoriteGroupResponse, error) {
+	return c.GetFavoriteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFavoriteGroupWithContext(ctx context.Context, params GetFavoriteGroupParams) (*FavoriteGroupResponse, error) {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{favoriteGroupType}", fmt.Sprintf("%v", params.FavoriteGroupType))
-	path = strings.ReplaceAll(path, "{favoriteGroupName}", fmt.Sprintf("%v", params.FavoriteGroupName))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{favoriteGroupType}", pathParam(params.FavoriteGroupType))
+	path = strings.ReplaceAll(path, "{favoriteGroupName}", pathParam(params.FavoriteGroupName))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -968,7 +1116,7 @@ func (c *Client) GetFavoriteGroup(params GetFavoriteGroupParams) (*FavoriteGroup
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavoriteGroup", resp)
 	}
 	return &result, nil
 }
@@ -981,18 +1129,25 @@ type UpdateFavoriteGroupParams struct {
 	UserId            string `json:"userId"`
 }
 
-func (c *Client) UpdateFavoriteGroup(params UpdateFavoriteGroupParams) error {
+// UpdateFavoriteGroup calls UpdateFavoriteGroupWithContext with context.Background().
+func (c *Client) UpdateFavoriteGroup(params UpdateFavoriteGroupParams, body UpdateFavoriteGroupRequest) error {
+	return c.UpdateFavoriteGroupWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateFavoriteGroupWithContext(ctx context.Context, params UpdateFavoriteGroupParams, body UpdateFavoriteGroupRequest) error {
 	path := "/favorite/group/{favoriteGroupType}/{favoriteGroupName}/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{favoriteGroupType}", fmt.Sprintf("%v", params.FavoriteGroupType))
-	path = strings.ReplaceAll(path, "{favoriteGroupName}", fmt.Sprintf("%v", params.FavoriteGroupName))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{favoriteGroupType}", pathParam(params.FavoriteGroupType))
+	path = strings.ReplaceAll(path, "{favoriteGroupName}", pathParam(params.FavoriteGroupName))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 
 	// Send request
 	resp, err := req.Put(path)
@@ -1002,30 +1157,35 @@ func (c *Client) UpdateFavoriteGroup(params UpdateFavoriteGroupParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("updateFavoriteGroup", resp)
 	}
 	return nil
 }
 
 // GetFilesParams represents the parameters for the GetFiles request
 type GetFilesParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      *int64 `json:"n"`
+	Offset *int64 `json:"offset"`
 }
 
+// GetFiles calls GetFilesWithContext with context.Background().
 func (c *Client) GetFiles(params GetFilesParams) (*FileListResponse, error) {
+	return c.GetFilesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFilesWithContext(ctx context.Context, params GetFilesParams) (*FileListResponse, error) {
 	path := "/files"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1040,16 +1200,23 @@ func (c *Client) GetFiles(params GetFilesParams) (*FileListResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFiles", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateFile() (*FileResponse, error) {
+// CreateFile calls CreateFileWithContext with context.Background().
+func (c *Client) CreateFile(body CreateFileRequest) (*FileResponse, error) {
+	return c.CreateFileWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateFileWithContext(ctx context.Context, body CreateFileRequest) (*FileResponse, error) {
 	path := "/file"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result FileResponse
 	req.SetResult(&result)
@@ -1062,7 +1229,7 @@ func (c *Client) CreateFile() (*FileResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createFile", resp)
 	}
 	return &result, nil
 }
@@ -1072,14 +1239,19 @@ type GetFileParams struct {
 	FileId string `json:"fileId"`
 }
 
+// GetFile calls GetFileWithContext with context.Background().
 func (c *Client) GetFile(params GetFileParams) (*FileResponse, error) {
+	return c.GetFileWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileWithContext(ctx context.Context, params GetFileParams) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1094,7 +1266,7 @@ func (c *Client) GetFile(params GetFileParams) (*FileResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFile", resp)
 	}
 	return &result, nil
 }
@@ -1104,16 +1276,23 @@ type CreateFileVersionParams struct {
 	FileId string `json:"fileId"`
 }
 
-func (c *Client) CreateFileVersion(params CreateFileVersionParams) (*FileResponse, error) {
+// CreateFileVersion calls CreateFileVersionWithContext with context.Background().
+func (c *Client) CreateFileVersion(params CreateFileVersionParams, body CreateFileVersionRequest) (*FileResponse, error) {
+	return c.CreateFileVersionWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateFileVersionWithContext(ctx context.Context, params CreateFileVersionParams, body CreateFileVersionRequest) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result FileResponse
 	req.SetResult(&result)
@@ -1126,7 +1305,7 @@ func (c *Client) CreateFileVersion(params CreateFileVersionParams) (*FileRespons
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createFileVersion", resp)
 	}
 	return &result, nil
 }
@@ -1136,14 +1315,19 @@ type DeleteFileParams struct {
 	FileId string `json:"fileId"`
 }
 
+// DeleteFile calls DeleteFileWithContext with context.Background().
 func (c *Client) DeleteFile(params DeleteFileParams) (*FileResponse, error) {
+	return c.DeleteFileWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFileWithContext(ctx context.Context, params DeleteFileParams) (*FileResponse, error) {
 	path := "/file/{fileId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1158,7 +1342,7 @@ func (c *Client) DeleteFile(params DeleteFileParams) (*FileResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteFile", resp)
 	}
 	return &result, nil
 }
@@ -1169,15 +1353,20 @@ type DeleteFileVersionParams struct {
 	VersionId int64  `json:"versionId"`
 }
 
+// DeleteFileVersion calls DeleteFileVersionWithContext with context.Background().
 func (c *Client) DeleteFileVersion(params DeleteFileVersionParams) (*FileResponse, error) {
+	return c.DeleteFileVersionWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFileVersionWithContext(ctx context.Context, params DeleteFileVersionParams) (*FileResponse, error) {
 	path := "/file/{fileId}/{versionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
-	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", pathParam(params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1192,7 +1381,7 @@ func (c *Client) DeleteFileVersion(params DeleteFileVersionParams) (*FileRespons
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteFileVersion", resp)
 	}
 	return &result, nil
 }
@@ -1203,32 +1392,42 @@ type DownloadFileVersionParams struct {
 	VersionId int64  `json:"versionId"`
 }
 
-func (c *Client) DownloadFileVersion(params DownloadFileVersionParams) (*RawFileResponse, error) {
+// DownloadFileVersion calls DownloadFileVersionWithContext with context.Background().
+func (c *Client) DownloadFileVersion(params DownloadFileVersionParams) (io.ReadCloser, error) {
+	return c.DownloadFileVersionWithContext(context.Background(), params)
+}
+
+func (c *Client) DownloadFileVersionWithContext(ctx context.Context, params DownloadFileVersionParams) (io.ReadCloser, error) {
+	resp, err := c.downloadFileVersion(ctx, params, nil)
+	if err != nil {
+		return nil, err
+	}
+	return streamResponse("downloadFileVersion", resp)
+}
+
+// downloadFileVersion sends the DownloadFileVersion request with the additional
+// headers and returns the response with its body unread.
+func (c *Client) downloadFileVersion(ctx context.Context, params DownloadFileVersionParams, headers map[string]string) (*resty.Response, error) {
 	path := "/file/{fileId}/{versionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
-	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", pathParam(params.VersionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
-	// Set response object
-	var result RawFileResponse
-	req.SetResult(&result)
+	req.SetHeaders(headers)
+	// Stream the response body
+	req.SetDoNotParseResponse(true)
 
 	// Send request
 	resp, err := req.Get(path)
 	if err != nil {
 		return nil, fmt.Errorf("error sending request: %w", err)
 	}
-
-	// Check for successful status code
-	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
-	}
-	return &result, nil
+	return resp, nil
 }
 
 // FinishFileDataUploadParams represents the parameters for the FinishFileDataUpload request
@@ -1239,18 +1438,25 @@ type FinishFileDataUploadParams struct {
 	FileType string `json:"fileType"`
 }
 
-func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams) (*FileResponse, error) {
+// FinishFileDataUpload calls FinishFileDataUploadWithContext with context.Background().
+func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams, body FinishFileDataUploadRequest) (*FileResponse, error) {
+	return c.FinishFileDataUploadWithContext(context.Background(), params, body)
+}
+
+func (c *Client) FinishFileDataUploadWithContext(ctx context.Context, params FinishFileDataUploadParams, body FinishFileDataUploadRequest) (*FileResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/finish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
-	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
-	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", pathParam(params.VersionId))
+	path = strings.ReplaceAll(path, "{fileType}", pathParam(params.FileType))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result FileResponse
 	req.SetResult(&result)
@@ -1263,7 +1469,7 @@ func (c *Client) FinishFileDataUpload(params FinishFileDataUploadParams) (*FileR
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("finishFileDataUpload", resp)
 	}
 	return &result, nil
 }
@@ -1273,19 +1479,28 @@ type StartFileDataUploadParams struct {
 	FileId    string `json:"fileId"`
 	VersionId int64  `json:"versionId"`
 	// FileType enum
-	FileType string `json:"fileType"`
+	FileType   string `json:"fileType"`
+	PartNumber *int64 `json:"partNumber"`
 }
 
+// StartFileDataUpload calls StartFileDataUploadWithContext with context.Background().
 func (c *Client) StartFileDataUpload(params StartFileDataUploadParams) (*FileUploadUrlResponse, error) {
+	return c.StartFileDataUploadWithContext(context.Background(), params)
+}
+
+func (c *Client) StartFileDataUploadWithContext(ctx context.Context, params StartFileDataUploadParams) (*FileUploadUrlResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/start"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
-	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
-	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", pathParam(params.VersionId))
+	path = strings.ReplaceAll(path, "{fileType}", pathParam(params.FileType))
+	if params.PartNumber != nil {
+		queryParams["partNumber"] = fmt.Sprintf("%v", *params.PartNumber)
+	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1300,7 +1515,7 @@ func (c *Client) StartFileDataUpload(params StartFileDataUploadParams) (*FileUpl
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("startFileDataUpload", resp)
 	}
 	return &result, nil
 }
@@ -1313,16 +1528,21 @@ type GetFileDataUploadStatusParams struct {
 	FileType string `json:"fileType"`
 }
 
+// GetFileDataUploadStatus calls GetFileDataUploadStatusWithContext with context.Background().
 func (c *Client) GetFileDataUploadStatus(params GetFileDataUploadStatusParams) (*FileVersionUploadStatusResponse, error) {
+	return c.GetFileDataUploadStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFileDataUploadStatusWithContext(ctx context.Context, params GetFileDataUploadStatusParams) (*FileVersionUploadStatusResponse, error) {
 	path := "/file/{fileId}/{versionId}/{fileType}/status"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{fileId}", fmt.Sprintf("%v", params.FileId))
-	path = strings.ReplaceAll(path, "{versionId}", fmt.Sprintf("%v", params.VersionId))
-	path = strings.ReplaceAll(path, "{fileType}", fmt.Sprintf("%v", params.FileType))
+	path = strings.ReplaceAll(path, "{fileId}", pathParam(params.FileId))
+	path = strings.ReplaceAll(path, "{versionId}", pathParam(params.VersionId))
+	path = strings.ReplaceAll(path, "{fileType}", pathParam(params.FileType))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1337,34 +1557,39 @@ func (c *Client) GetFileDataUploadStatus(params GetFileDataUploadStatusParams) (
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFileDataUploadStatus", resp)
 	}
 	return &result, nil
 }
 
 // GetFriendsParams represents the parameters for the GetFriends request
 type GetFriendsParams struct {
-	Offset  int64 `json:"offset"`
-	N       int64 `json:"n"`
-	Offline bool  `json:"offline"`
+	Offset  *int64 `json:"offset"`
+	N       *int64 `json:"n"`
+	Offline *bool  `json:"offline"`
 }
 
+// GetFriends calls GetFriendsWithContext with context.Background().
 func (c *Client) GetFriends(params GetFriendsParams) (*LimitedUserListResponse, error) {
+	return c.GetFriendsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFriendsWithContext(ctx context.Context, params GetFriendsParams) (*LimitedUserListResponse, error) {
 	path := "/auth/user/friends"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offline) {
-		queryParams["offline"] = fmt.Sprintf("%v", params.Offline)
+	if params.Offline != nil {
+		queryParams["offline"] = fmt.Sprintf("%v", *params.Offline)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1379,7 +1604,7 @@ func (c *Client) GetFriends(params GetFriendsParams) (*LimitedUserListResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFriends", resp)
 	}
 	return &result, nil
 }
@@ -1389,14 +1614,19 @@ type DeleteFriendRequestParams struct {
 	UserId string `json:"userId"`
 }
 
+// DeleteFriendRequest calls DeleteFriendRequestWithContext with context.Background().
 func (c *Client) DeleteFriendRequest(params DeleteFriendRequestParams) (*DeleteFriendSuccess, error) {
+	return c.DeleteFriendRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteFriendRequestWithContext(ctx context.Context, params DeleteFriendRequestParams) (*DeleteFriendSuccess, error) {
 	path := "/user/{userId}/friendRequest"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1411,7 +1641,7 @@ func (c *Client) DeleteFriendRequest(params DeleteFriendRequestParams) (*DeleteF
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteFriendRequest", resp)
 	}
 	return &result, nil
 }
@@ -1421,14 +1651,19 @@ type FriendParams struct {
 	UserId string `json:"userId"`
 }
 
+// Friend calls FriendWithContext with context.Background().
 func (c *Client) Friend(params FriendParams) (*NotificationResponse, error) {
+	return c.FriendWithContext(context.Background(), params)
+}
+
+func (c *Client) FriendWithContext(ctx context.Context, params FriendParams) (*NotificationResponse, error) {
 	path := "/user/{userId}/friendRequest"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1443,7 +1678,7 @@ func (c *Client) Friend(params FriendParams) (*NotificationResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("friend", resp)
 	}
 	return &result, nil
 }
@@ -1453,14 +1688,19 @@ type GetFriendStatusParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetFriendStatus calls GetFriendStatusWithContext with context.Background().
 func (c *Client) GetFriendStatus(params GetFriendStatusParams) (*FriendStatusResponse, error) {
+	return c.GetFriendStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetFriendStatusWithContext(ctx context.Context, params GetFriendStatusParams) (*FriendStatusResponse, error) {
 	path := "/user/{userId}/friendStatus"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1475,7 +1715,7 @@ func (c *Client) GetFriendStatus(params GetFriendStatusParams) (*FriendStatusRes
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFriendStatus", resp)
 	}
 	return &result, nil
 }
@@ -1485,14 +1725,19 @@ type UnfriendParams struct {
 	UserId string `json:"userId"`
 }
 
+// Unfriend calls UnfriendWithContext with context.Background().
 func (c *Client) Unfriend(params UnfriendParams) (*UnfriendSuccess, error) {
+	return c.UnfriendWithContext(context.Background(), params)
+}
+
+func (c *Client) UnfriendWithContext(ctx context.Context, params UnfriendParams) (*UnfriendSuccess, error) {
 	path := "/auth/user/friends/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1507,30 +1752,35 @@ func (c *Client) Unfriend(params UnfriendParams) (*UnfriendSuccess, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("unfriend", resp)
 	}
 	return &result, nil
 }
 
 // SearchGroupsParams represents the parameters for the SearchGroups request
 type SearchGroupsParams struct {
-	Offset int64 `json:"offset"`
-	N      int64 `json:"n"`
+	Offset *int64 `json:"offset"`
+	N      *int64 `json:"n"`
 }
 
+// SearchGroups calls SearchGroupsWithContext with context.Background().
 func (c *Client) SearchGroups(params SearchGroupsParams) (*LimitedGroupListResponse, error) {
+	return c.SearchGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchGroupsWithContext(ctx context.Context, params SearchGroupsParams) (*LimitedGroupListResponse, error) {
 	path := "/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1545,16 +1795,23 @@ func (c *Client) SearchGroups(params SearchGroupsParams) (*LimitedGroupListRespo
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("searchGroups", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateGroup() (*GroupResponse, error) {
+// CreateGroup calls CreateGroupWithContext with context.Background().
+func (c *Client) CreateGroup(body CreateGroupRequest) (*GroupResponse, error) {
+	return c.CreateGroupWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateGroupWithContext(ctx context.Context, body CreateGroupRequest) (*GroupResponse, error) {
 	path := "/groups"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupResponse
 	req.SetResult(&result)
@@ -1567,7 +1824,7 @@ func (c *Client) CreateGroup() (*GroupResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createGroup", resp)
 	}
 	return &result, nil
 }
@@ -1577,16 +1834,23 @@ type UpdateGroupParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) UpdateGroup(params UpdateGroupParams) (*GroupResponse, error) {
+// UpdateGroup calls UpdateGroupWithContext with context.Background().
+func (c *Client) UpdateGroup(params UpdateGroupParams, body UpdateGroupRequest) (*GroupResponse, error) {
+	return c.UpdateGroupWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupWithContext(ctx context.Context, params UpdateGroupParams, body UpdateGroupRequest) (*GroupResponse, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupResponse
 	req.SetResult(&result)
@@ -1599,7 +1863,7 @@ func (c *Client) UpdateGroup(params UpdateGroupParams) (*GroupResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateGroup", resp)
 	}
 	return &result, nil
 }
@@ -1609,14 +1873,19 @@ type DeleteGroupParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// DeleteGroup calls DeleteGroupWithContext with context.Background().
 func (c *Client) DeleteGroup(params DeleteGroupParams) (*DeleteGroupSuccess, error) {
+	return c.DeleteGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupWithContext(ctx context.Context, params DeleteGroupParams) (*DeleteGroupSuccess, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1631,7 +1900,7 @@ func (c *Client) DeleteGroup(params DeleteGroupParams) (*DeleteGroupSuccess, err
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroup", resp)
 	}
 	return &result, nil
 }
@@ -1641,14 +1910,19 @@ type GetGroupParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// GetGroup calls GetGroupWithContext with context.Background().
 func (c *Client) GetGroup(params GetGroupParams) (*GroupResponse, error) {
+	return c.GetGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupWithContext(ctx context.Context, params GetGroupParams) (*GroupResponse, error) {
 	path := "/groups/{groupId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1663,7 +1937,7 @@ func (c *Client) GetGroup(params GetGroupParams) (*GroupResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroup", resp)
 	}
 	return &result, nil
 }
@@ -1673,14 +1947,19 @@ type DeleteGroupAnnouncementParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// DeleteGroupAnnouncement calls DeleteGroupAnnouncementWithContext with context.Background().
 func (c *Client) DeleteGroupAnnouncement(params DeleteGroupAnnouncementParams) (*DeleteGroupAnnouncementSuccess, error) {
+	return c.DeleteGroupAnnouncementWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupAnnouncementWithContext(ctx context.Context, params DeleteGroupAnnouncementParams) (*DeleteGroupAnnouncementSuccess, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1695,7 +1974,7 @@ func (c *Client) DeleteGroupAnnouncement(params DeleteGroupAnnouncementParams) (
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroupAnnouncement", resp)
 	}
 	return &result, nil
 }
@@ -1705,14 +1984,19 @@ type GetGroupAnnouncementsParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// GetGroupAnnouncements calls GetGroupAnnouncementsWithContext with context.Background().
 func (c *Client) GetGroupAnnouncements(params GetGroupAnnouncementsParams) (*GroupAnnouncementResponse, error) {
+	return c.GetGroupAnnouncementsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupAnnouncementsWithContext(ctx context.Context, params GetGroupAnnouncementsParams) (*GroupAnnouncementResponse, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1727,7 +2011,7 @@ func (c *Client) GetGroupAnnouncements(params GetGroupAnnouncementsParams) (*Gro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupAnnouncements", resp)
 	}
 	return &result, nil
 }
@@ -1737,16 +2021,23 @@ type CreateGroupAnnouncementParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) CreateGroupAnnouncement(params CreateGroupAnnouncementParams) (*GroupAnnouncementResponse, error) {
+// CreateGroupAnnouncement calls CreateGroupAnnouncementWithContext with context.Background().
+func (c *Client) CreateGroupAnnouncement(params CreateGroupAnnouncementParams, body CreateGroupAnnouncementRequest) (*GroupAnnouncementResponse, error) {
+	return c.CreateGroupAnnouncementWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupAnnouncementWithContext(ctx context.Context, params CreateGroupAnnouncementParams, body CreateGroupAnnouncementRequest) (*GroupAnnouncementResponse, error) {
 	path := "/groups/{groupId}/announcement"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupAnnouncementResponse
 	req.SetResult(&result)
@@ -1759,40 +2050,45 @@ func (c *Client) CreateGroupAnnouncement(params CreateGroupAnnouncementParams) (
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createGroupAnnouncement", resp)
 	}
 	return &result, nil
 }
 
 // GetGroupAuditLogsParams represents the parameters for the GetGroupAuditLogs request
 type GetGroupAuditLogsParams struct {
-	GroupId   string    `json:"groupId"`
-	N         int64     `json:"n"`
-	Offset    int64     `json:"offset"`
-	StartDate time.Time `json:"startDate"`
-	EndDate   time.Time `json:"endDate"`
+	GroupId   string     `json:"groupId"`
+	N         *int64     `json:"n"`
+	Offset    *int64     `json:"offset"`
+	StartDate *time.Time `json:"startDate"`
+	EndDate   *time.Time `json:"endDate"`
 }
 
+// GetGroupAuditLogs calls GetGroupAuditLogsWithContext with context.Background().
 func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditLogListResponse, error) {
+	return c.GetGroupAuditLogsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupAuditLogsWithContext(ctx context.Context, params GetGroupAuditLogsParams) (*GroupAuditLogListResponse, error) {
 	path := "/groups/{groupId}/auditLogs"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.StartDate) {
-		queryParams["startDate"] = fmt.Sprintf("%v", params.StartDate)
+	if params.StartDate != nil {
+		queryParams["startDate"] = fmt.Sprintf("%v", *params.StartDate)
 	}
-	if lo.IsNotEmpty(params.EndDate) {
-		queryParams["endDate"] = fmt.Sprintf("%v", params.EndDate)
+	if params.EndDate != nil {
+		queryParams["endDate"] = fmt.Sprintf("%v", *params.EndDate)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1807,7 +2103,7 @@ func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditL
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupAuditLogs", resp)
 	}
 	return &result, nil
 }
@@ -1815,24 +2111,29 @@ func (c *Client) GetGroupAuditLogs(params GetGroupAuditLogsParams) (*GroupAuditL
 // GetGroupBansParams represents the parameters for the GetGroupBans request
 type GetGroupBansParams struct {
 	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	N       *int64 `json:"n"`
+	Offset  *int64 `json:"offset"`
 }
 
+// GetGroupBans calls GetGroupBansWithContext with context.Background().
 func (c *Client) GetGroupBans(params GetGroupBansParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupBansWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupBansWithContext(ctx context.Context, params GetGroupBansParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/bans"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1847,7 +2148,7 @@ func (c *Client) GetGroupBans(params GetGroupBansParams) (*GroupMemberListRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupBans", resp)
 	}
 	return &result, nil
 }
@@ -1857,16 +2158,23 @@ type BanGroupMemberParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) BanGroupMember(params BanGroupMemberParams) (*GroupMemberResponse, error) {
+// BanGroupMember calls BanGroupMemberWithContext with context.Background().
+func (c *Client) BanGroupMember(params BanGroupMemberParams, body BanGroupMemberRequest) (*GroupMemberResponse, error) {
+	return c.BanGroupMemberWithContext(context.Background(), params, body)
+}
+
+func (c *Client) BanGroupMemberWithContext(ctx context.Context, params BanGroupMemberParams, body BanGroupMemberRequest) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/bans"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupMemberResponse
 	req.SetResult(&result)
@@ -1879,7 +2187,7 @@ func (c *Client) BanGroupMember(params BanGroupMemberParams) (*GroupMemberRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("banGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -1890,15 +2198,20 @@ type UnbanGroupMemberParams struct {
 	UserId  string `json:"userId"`
 }
 
+// UnbanGroupMember calls UnbanGroupMemberWithContext with context.Background().
 func (c *Client) UnbanGroupMember(params UnbanGroupMemberParams) (*GroupMemberResponse, error) {
+	return c.UnbanGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) UnbanGroupMemberWithContext(ctx context.Context, params UnbanGroupMemberParams) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/bans/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1913,7 +2226,7 @@ func (c *Client) UnbanGroupMember(params UnbanGroupMemberParams) (*GroupMemberRe
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("unbanGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -1923,16 +2236,23 @@ type CreateGroupGalleryParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) CreateGroupGallery(params CreateGroupGalleryParams) (*GroupGalleryResponse, error) {
+// CreateGroupGallery calls CreateGroupGalleryWithContext with context.Background().
+func (c *Client) CreateGroupGallery(params CreateGroupGalleryParams, body CreateGroupGalleryRequest) (*GroupGalleryResponse, error) {
+	return c.CreateGroupGalleryWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupGalleryWithContext(ctx context.Context, params CreateGroupGalleryParams, body CreateGroupGalleryRequest) (*GroupGalleryResponse, error) {
 	path := "/groups/{groupId}/galleries"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupGalleryResponse
 	req.SetResult(&result)
@@ -1945,7 +2265,7 @@ func (c *Client) CreateGroupGallery(params CreateGroupGalleryParams) (*GroupGall
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createGroupGallery", resp)
 	}
 	return &result, nil
 }
@@ -1956,15 +2276,20 @@ type DeleteGroupGalleryParams struct {
 	GroupGalleryId string `json:"groupGalleryId"`
 }
 
+// DeleteGroupGallery calls DeleteGroupGalleryWithContext with context.Background().
 func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGroupGallerySuccess, error) {
+	return c.DeleteGroupGalleryWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupGalleryWithContext(ctx context.Context, params DeleteGroupGalleryParams) (*DeleteGroupGallerySuccess, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupGalleryId}", pathParam(params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -1979,7 +2304,7 @@ func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroupGallery", resp)
 	}
 	return &result, nil
 }
@@ -1988,25 +2313,30 @@ func (c *Client) DeleteGroupGallery(params DeleteGroupGalleryParams) (*DeleteGro
 type GetGroupGalleryImagesParams struct {
 	GroupId        string `json:"groupId"`
 	GroupGalleryId string `json:"groupGalleryId"`
-	N              int64  `json:"n"`
-	Offset         int64  `json:"offset"`
+	N              *int64 `json:"n"`
+	Offset         *int64 `json:"offset"`
 }
 
+// GetGroupGalleryImages calls GetGroupGalleryImagesWithContext with context.Background().
 func (c *Client) GetGroupGalleryImages(params GetGroupGalleryImagesParams) (*GroupGalleryImageListResponse, error) {
+	return c.GetGroupGalleryImagesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupGalleryImagesWithContext(ctx context.Context, params GetGroupGalleryImagesParams) (*GroupGalleryImageListResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupGalleryId}", pathParam(params.GroupGalleryId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2021,7 +2351,7 @@ func (c *Client) GetGroupGalleryImages(params GetGroupGalleryImagesParams) (*Gro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupGalleryImages", resp)
 	}
 	return &result, nil
 }
@@ -2032,17 +2362,24 @@ type UpdateGroupGalleryParams struct {
 	GroupGalleryId string `json:"groupGalleryId"`
 }
 
-func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams) (*GroupGalleryResponse, error) {
+// UpdateGroupGallery calls UpdateGroupGalleryWithContext with context.Background().
+func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams, body UpdateGroupGalleryRequest) (*GroupGalleryResponse, error) {
+	return c.UpdateGroupGalleryWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupGalleryWithContext(ctx context.Context, params UpdateGroupGalleryParams, body UpdateGroupGalleryRequest) (*GroupGalleryResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupGalleryId}", pathParam(params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupGalleryResponse
 	req.SetResult(&result)
@@ -2055,7 +2392,7 @@ func (c *Client) UpdateGroupGallery(params UpdateGroupGalleryParams) (*GroupGall
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateGroupGallery", resp)
 	}
 	return &result, nil
 }
@@ -2066,17 +2403,24 @@ type AddGroupGalleryImageParams struct {
 	GroupGalleryId string `json:"groupGalleryId"`
 }
 
-func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams) (*GroupGalleryImageResponse, error) {
+// AddGroupGalleryImage calls AddGroupGalleryImageWithContext with context.Background().
+func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams, body AddGroupGalleryImageRequest) (*GroupGalleryImageResponse, error) {
+	return c.AddGroupGalleryImageWithContext(context.Background(), params, body)
+}
+
+func (c *Client) AddGroupGalleryImageWithContext(ctx context.Context, params AddGroupGalleryImageParams, body AddGroupGalleryImageRequest) (*GroupGalleryImageResponse, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}/images"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupGalleryId}", pathParam(params.GroupGalleryId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupGalleryImageResponse
 	req.SetResult(&result)
@@ -2089,7 +2433,7 @@ func (c *Client) AddGroupGalleryImage(params AddGroupGalleryImageParams) (*Group
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("addGroupGalleryImage", resp)
 	}
 	return &result, nil
 }
@@ -2101,16 +2445,21 @@ type DeleteGroupGalleryImageParams struct {
 	GroupGalleryImageId string `json:"groupGalleryImageId"`
 }
 
+// DeleteGroupGalleryImage calls DeleteGroupGalleryImageWithContext with context.Background().
 func (c *Client) DeleteGroupGalleryImage(params DeleteGroupGalleryImageParams) (*DeleteGroupGalleryImageSuccess, error) {
+	return c.DeleteGroupGalleryImageWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupGalleryImageWithContext(ctx context.Context, params DeleteGroupGalleryImageParams) (*DeleteGroupGalleryImageSuccess, error) {
 	path := "/groups/{groupId}/galleries/{groupGalleryId}/images/{groupGalleryImageId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupGalleryId}", fmt.Sprintf("%v", params.GroupGalleryId))
-	path = strings.ReplaceAll(path, "{groupGalleryImageId}", fmt.Sprintf("%v", params.GroupGalleryImageId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupGalleryId}", pathParam(params.GroupGalleryId))
+	path = strings.ReplaceAll(path, "{groupGalleryImageId}", pathParam(params.GroupGalleryImageId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2125,7 +2474,7 @@ func (c *Client) DeleteGroupGalleryImage(params DeleteGroupGalleryImageParams) (
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroupGalleryImage", resp)
 	}
 	return &result, nil
 }
@@ -2135,14 +2484,19 @@ type GetGroupInstancesParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// GetGroupInstances calls GetGroupInstancesWithContext with context.Background().
 func (c *Client) GetGroupInstances(params GetGroupInstancesParams) (*GroupInstanceListResponse, error) {
+	return c.GetGroupInstancesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupInstancesWithContext(ctx context.Context, params GetGroupInstancesParams) (*GroupInstanceListResponse, error) {
 	path := "/groups/{groupId}/instances"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2157,7 +2511,7 @@ func (c *Client) GetGroupInstances(params GetGroupInstancesParams) (*GroupInstan
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupInstances", resp)
 	}
 	return &result, nil
 }
@@ -2165,24 +2519,29 @@ func (c *Client) GetGroupInstances(params GetGroupInstancesParams) (*GroupInstan
 // GetGroupInvitesParams represents the parameters for the GetGroupInvites request
 type GetGroupInvitesParams struct {
 	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	N       *int64 `json:"n"`
+	Offset  *int64 `json:"offset"`
 }
 
+// GetGroupInvites calls GetGroupInvitesWithContext with context.Background().
 func (c *Client) GetGroupInvites(params GetGroupInvitesParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupInvitesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupInvitesWithContext(ctx context.Context, params GetGroupInvitesParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/invites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2197,7 +2556,7 @@ func (c *Client) GetGroupInvites(params GetGroupInvitesParams) (*GroupMemberList
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupInvites", resp)
 	}
 	return &result, nil
 }
@@ -2207,16 +2566,23 @@ type CreateGroupInviteParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) CreateGroupInvite(params CreateGroupInviteParams) error {
+// CreateGroupInvite calls CreateGroupInviteWithContext with context.Background().
+func (c *Client) CreateGroupInvite(params CreateGroupInviteParams, body CreateGroupInviteRequest) error {
+	return c.CreateGroupInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupInviteWithContext(ctx context.Context, params CreateGroupInviteParams, body CreateGroupInviteRequest) error {
 	path := "/groups/{groupId}/invites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 
 	// Send request
 	resp, err := req.Post(path)
@@ -2226,7 +2592,7 @@ func (c *Client) CreateGroupInvite(params CreateGroupInviteParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("createGroupInvite", resp)
 	}
 	return nil
 }
@@ -2237,15 +2603,20 @@ type DeleteGroupInviteParams struct {
 	UserId  string `json:"userId"`
 }
 
+// DeleteGroupInvite calls DeleteGroupInviteWithContext with context.Background().
 func (c *Client) DeleteGroupInvite(params DeleteGroupInviteParams) error {
+	return c.DeleteGroupInviteWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupInviteWithContext(ctx context.Context, params DeleteGroupInviteParams) error {
 	path := "/groups/{groupId}/invites/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -2257,7 +2628,7 @@ func (c *Client) DeleteGroupInvite(params DeleteGroupInviteParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("deleteGroupInvite", resp)
 	}
 	return nil
 }
@@ -2267,14 +2638,19 @@ type JoinGroupParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// JoinGroup calls JoinGroupWithContext with context.Background().
 func (c *Client) JoinGroup(params JoinGroupParams) (*GroupMemberResponse, error) {
+	return c.JoinGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) JoinGroupWithContext(ctx context.Context, params JoinGroupParams) (*GroupMemberResponse, error) {
 	path := "/groups/{groupId}/join"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2289,7 +2665,7 @@ func (c *Client) JoinGroup(params JoinGroupParams) (*GroupMemberResponse, error)
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("joinGroup", resp)
 	}
 	return &result, nil
 }
@@ -2299,14 +2675,19 @@ type LeaveGroupParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// LeaveGroup calls LeaveGroupWithContext with context.Background().
 func (c *Client) LeaveGroup(params LeaveGroupParams) error {
+	return c.LeaveGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) LeaveGroupWithContext(ctx context.Context, params LeaveGroupParams) error {
 	path := "/groups/{groupId}/leave"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -2318,36 +2699,41 @@ func (c *Client) LeaveGroup(params LeaveGroupParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("leaveGroup", resp)
 	}
 	return nil
 }
 
 // GetGroupMembersParams represents the parameters for the GetGroupMembers request
 type GetGroupMembersParams struct {
-	GroupId string          `json:"groupId"`
-	N       int64           `json:"n"`
-	Offset  int64           `json:"offset"`
-	Sort    GroupSearchSort `json:"sort"`
+	GroupId string           `json:"groupId"`
+	N       *int64           `json:"n"`
+	Offset  *int64           `json:"offset"`
+	Sort    *GroupSearchSort `json:"sort"`
 }
 
+// GetGroupMembers calls GetGroupMembersWithContext with context.Background().
 func (c *Client) GetGroupMembers(params GetGroupMembersParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupMembersWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupMembersWithContext(ctx context.Context, params GetGroupMembersParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/members"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2362,7 +2748,7 @@ func (c *Client) GetGroupMembers(params GetGroupMembersParams) (*GroupMemberList
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupMembers", resp)
 	}
 	return &result, nil
 }
@@ -2373,15 +2759,20 @@ type KickGroupMemberParams struct {
 	UserId  string `json:"userId"`
 }
 
+// KickGroupMember calls KickGroupMemberWithContext with context.Background().
 func (c *Client) KickGroupMember(params KickGroupMemberParams) error {
+	return c.KickGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) KickGroupMemberWithContext(ctx context.Context, params KickGroupMemberParams) error {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -2393,7 +2784,7 @@ func (c *Client) KickGroupMember(params KickGroupMemberParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("kickGroupMember", resp)
 	}
 	return nil
 }
@@ -2404,15 +2795,20 @@ type GetGroupMemberParams struct {
 	UserId  string `json:"userId"`
 }
 
+// GetGroupMember calls GetGroupMemberWithContext with context.Background().
 func (c *Client) GetGroupMember(params GetGroupMemberParams) (*GroupLimitedMemberResponse, error) {
+	return c.GetGroupMemberWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupMemberWithContext(ctx context.Context, params GetGroupMemberParams) (*GroupLimitedMemberResponse, error) {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2427,7 +2823,7 @@ func (c *Client) GetGroupMember(params GetGroupMemberParams) (*GroupLimitedMembe
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -2438,17 +2834,24 @@ type UpdateGroupMemberParams struct {
 	UserId  string `json:"userId"`
 }
 
-func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams) (*GroupLimitedMemberResponse, error) {
+// UpdateGroupMember calls UpdateGroupMemberWithContext with context.Background().
+func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams, body UpdateGroupMemberRequest) (*GroupLimitedMemberResponse, error) {
+	return c.UpdateGroupMemberWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupMemberWithContext(ctx context.Context, params UpdateGroupMemberParams, body UpdateGroupMemberRequest) (*GroupLimitedMemberResponse, error) {
 	path := "/groups/{groupId}/members/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupLimitedMemberResponse
 	req.SetResult(&result)
@@ -2461,7 +2864,7 @@ func (c *Client) UpdateGroupMember(params UpdateGroupMemberParams) (*GroupLimite
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateGroupMember", resp)
 	}
 	return &result, nil
 }
@@ -2473,16 +2876,21 @@ type RemoveGroupMemberRoleParams struct {
 	GroupRoleId string `json:"groupRoleId"`
 }
 
+// RemoveGroupMemberRole calls RemoveGroupMemberRoleWithContext with context.Background().
 func (c *Client) RemoveGroupMemberRole(params RemoveGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
+	return c.RemoveGroupMemberRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) RemoveGroupMemberRoleWithContext(ctx context.Context, params RemoveGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
 	path := "/groups/{groupId}/members/{userId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{groupRoleId}", pathParam(params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2497,7 +2905,7 @@ func (c *Client) RemoveGroupMemberRole(params RemoveGroupMemberRoleParams) (*Gro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("removeGroupMemberRole", resp)
 	}
 	return &result, nil
 }
@@ -2509,16 +2917,21 @@ type AddGroupMemberRoleParams struct {
 	GroupRoleId string `json:"groupRoleId"`
 }
 
+// AddGroupMemberRole calls AddGroupMemberRoleWithContext with context.Background().
 func (c *Client) AddGroupMemberRole(params AddGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
+	return c.AddGroupMemberRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) AddGroupMemberRoleWithContext(ctx context.Context, params AddGroupMemberRoleParams) (*GroupRoleIdListResponse, error) {
 	path := "/groups/{groupId}/members/{userId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{groupRoleId}", pathParam(params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2533,7 +2946,7 @@ func (c *Client) AddGroupMemberRole(params AddGroupMemberRoleParams) (*GroupRole
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("addGroupMemberRole", resp)
 	}
 	return &result, nil
 }
@@ -2543,14 +2956,19 @@ type GetGroupPermissionsParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// GetGroupPermissions calls GetGroupPermissionsWithContext with context.Background().
 func (c *Client) GetGroupPermissions(params GetGroupPermissionsParams) (*GroupPermissionListResponse, error) {
+	return c.GetGroupPermissionsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupPermissionsWithContext(ctx context.Context, params GetGroupPermissionsParams) (*GroupPermissionListResponse, error) {
 	path := "/groups/{groupId}/permissions"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2565,7 +2983,7 @@ func (c *Client) GetGroupPermissions(params GetGroupPermissionsParams) (*GroupPe
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupPermissions", resp)
 	}
 	return &result, nil
 }
@@ -2573,24 +2991,29 @@ func (c *Client) GetGroupPermissions(params GetGroupPermissionsParams) (*GroupPe
 // GetGroupPostParams represents the parameters for the GetGroupPost request
 type GetGroupPostParams struct {
 	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	N       *int64 `json:"n"`
+	Offset  *int64 `json:"offset"`
 }
 
+// GetGroupPost calls GetGroupPostWithContext with context.Background().
 func (c *Client) GetGroupPost(params GetGroupPostParams) (*GroupPostResponse, error) {
+	return c.GetGroupPostWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupPostWithContext(ctx context.Context, params GetGroupPostParams) (*GroupPostResponse, error) {
 	path := "/groups/{groupId}/posts"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2605,7 +3028,7 @@ func (c *Client) GetGroupPost(params GetGroupPostParams) (*GroupPostResponse, er
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -2615,16 +3038,23 @@ type AddGroupPostParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) AddGroupPost(params AddGroupPostParams) (*GroupPostResponse, error) {
+// AddGroupPost calls AddGroupPostWithContext with context.Background().
+func (c *Client) AddGroupPost(params AddGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
+	return c.AddGroupPostWithContext(context.Background(), params, body)
+}
+
+func (c *Client) AddGroupPostWithContext(ctx context.Context, params AddGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
 	path := "/groups/{groupId}/posts"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupPostResponse
 	req.SetResult(&result)
@@ -2637,7 +3067,7 @@ func (c *Client) AddGroupPost(params AddGroupPostParams) (*GroupPostResponse, er
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("addGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -2648,15 +3078,20 @@ type DeleteGroupPostParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
+// DeleteGroupPost calls DeleteGroupPostWithContext with context.Background().
 func (c *Client) DeleteGroupPost(params DeleteGroupPostParams) (*GroupPostResponseSuccess, error) {
+	return c.DeleteGroupPostWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupPostWithContext(ctx context.Context, params DeleteGroupPostParams) (*GroupPostResponseSuccess, error) {
 	path := "/groups/{groupId}/posts/{notificationId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2671,7 +3106,7 @@ func (c *Client) DeleteGroupPost(params DeleteGroupPostParams) (*GroupPostRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -2682,17 +3117,24 @@ type UpdateGroupPostParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
-func (c *Client) UpdateGroupPost(params UpdateGroupPostParams) (*GroupPostResponse, error) {
+// UpdateGroupPost calls UpdateGroupPostWithContext with context.Background().
+func (c *Client) UpdateGroupPost(params UpdateGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
+	return c.UpdateGroupPostWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupPostWithContext(ctx context.Context, params UpdateGroupPostParams, body CreateGroupPostRequest) (*GroupPostResponse, error) {
 	path := "/groups/{groupId}/posts/{notificationId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupPostResponse
 	req.SetResult(&result)
@@ -2705,7 +3147,7 @@ func (c *Client) UpdateGroupPost(params UpdateGroupPostParams) (*GroupPostRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateGroupPost", resp)
 	}
 	return &result, nil
 }
@@ -2715,14 +3157,19 @@ type CancelGroupRequestParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// CancelGroupRequest calls CancelGroupRequestWithContext with context.Background().
 func (c *Client) CancelGroupRequest(params CancelGroupRequestParams) error {
+	return c.CancelGroupRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) CancelGroupRequestWithContext(ctx context.Context, params CancelGroupRequestParams) error {
 	path := "/groups/{groupId}/requests"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -2734,7 +3181,7 @@ func (c *Client) CancelGroupRequest(params CancelGroupRequestParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("cancelGroupRequest", resp)
 	}
 	return nil
 }
@@ -2742,24 +3189,29 @@ func (c *Client) CancelGroupRequest(params CancelGroupRequestParams) error {
 // GetGroupRequestsParams represents the parameters for the GetGroupRequests request
 type GetGroupRequestsParams struct {
 	GroupId string `json:"groupId"`
-	N       int64  `json:"n"`
-	Offset  int64  `json:"offset"`
+	N       *int64 `json:"n"`
+	Offset  *int64 `json:"offset"`
 }
 
+// GetGroupRequests calls GetGroupRequestsWithContext with context.Background().
 func (c *Client) GetGroupRequests(params GetGroupRequestsParams) (*GroupMemberListResponse, error) {
+	return c.GetGroupRequestsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupRequestsWithContext(ctx context.Context, params GetGroupRequestsParams) (*GroupMemberListResponse, error) {
 	path := "/groups/{groupId}/requests"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2774,7 +3226,7 @@ func (c *Client) GetGroupRequests(params GetGroupRequestsParams) (*GroupMemberLi
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupRequests", resp)
 	}
 	return &result, nil
 }
@@ -2785,17 +3237,24 @@ type RespondGroupJoinRequestParams struct {
 	UserId  string `json:"userId"`
 }
 
-func (c *Client) RespondGroupJoinRequest(params RespondGroupJoinRequestParams) error {
+// RespondGroupJoinRequest calls RespondGroupJoinRequestWithContext with context.Background().
+func (c *Client) RespondGroupJoinRequest(params RespondGroupJoinRequestParams, body RespondGroupJoinRequest) error {
+	return c.RespondGroupJoinRequestWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RespondGroupJoinRequestWithContext(ctx context.Context, params RespondGroupJoinRequestParams, body RespondGroupJoinRequest) error {
 	path := "/groups/{groupId}/requests/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 
 	// Send request
 	resp, err := req.Put(path)
@@ -2805,7 +3264,7 @@ func (c *Client) RespondGroupJoinRequest(params RespondGroupJoinRequestParams) e
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("respondGroupJoinRequest", resp)
 	}
 	return nil
 }
@@ -2815,14 +3274,19 @@ type GetGroupRolesParams struct {
 	GroupId string `json:"groupId"`
 }
 
+// GetGroupRoles calls GetGroupRolesWithContext with context.Background().
 func (c *Client) GetGroupRoles(params GetGroupRolesParams) (*GroupRoleListResponse, error) {
+	return c.GetGroupRolesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetGroupRolesWithContext(ctx context.Context, params GetGroupRolesParams) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2837,7 +3301,7 @@ func (c *Client) GetGroupRoles(params GetGroupRolesParams) (*GroupRoleListRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getGroupRoles", resp)
 	}
 	return &result, nil
 }
@@ -2847,16 +3311,23 @@ type CreateGroupRoleParams struct {
 	GroupId string `json:"groupId"`
 }
 
-func (c *Client) CreateGroupRole(params CreateGroupRoleParams) (*GroupRoleResponse, error) {
+// CreateGroupRole calls CreateGroupRoleWithContext with context.Background().
+func (c *Client) CreateGroupRole(params CreateGroupRoleParams, body CreateGroupRoleRequest) (*GroupRoleResponse, error) {
+	return c.CreateGroupRoleWithContext(context.Background(), params, body)
+}
+
+func (c *Client) CreateGroupRoleWithContext(ctx context.Context, params CreateGroupRoleParams, body CreateGroupRoleRequest) (*GroupRoleResponse, error) {
 	path := "/groups/{groupId}/roles"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupRoleResponse
 	req.SetResult(&result)
@@ -2869,7 +3340,7 @@ func (c *Client) CreateGroupRole(params CreateGroupRoleParams) (*GroupRoleRespon
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createGroupRole", resp)
 	}
 	return &result, nil
 }
@@ -2880,15 +3351,20 @@ type DeleteGroupRoleParams struct {
 	GroupRoleId string `json:"groupRoleId"`
 }
 
+// DeleteGroupRole calls DeleteGroupRoleWithContext with context.Background().
 func (c *Client) DeleteGroupRole(params DeleteGroupRoleParams) (*GroupRoleListResponse, error) {
+	return c.DeleteGroupRoleWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteGroupRoleWithContext(ctx context.Context, params DeleteGroupRoleParams) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupRoleId}", pathParam(params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -2903,7 +3379,7 @@ func (c *Client) DeleteGroupRole(params DeleteGroupRoleParams) (*GroupRoleListRe
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteGroupRole", resp)
 	}
 	return &result, nil
 }
@@ -2914,17 +3390,24 @@ type UpdateGroupRoleParams struct {
 	GroupRoleId string `json:"groupRoleId"`
 }
 
-func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams) (*GroupRoleListResponse, error) {
+// UpdateGroupRole calls UpdateGroupRoleWithContext with context.Background().
+func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams, body UpdateGroupRoleRequest) (*GroupRoleListResponse, error) {
+	return c.UpdateGroupRoleWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateGroupRoleWithContext(ctx context.Context, params UpdateGroupRoleParams, body UpdateGroupRoleRequest) (*GroupRoleListResponse, error) {
 	path := "/groups/{groupId}/roles/{groupRoleId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{groupId}", fmt.Sprintf("%v", params.GroupId))
-	path = strings.ReplaceAll(path, "{groupRoleId}", fmt.Sprintf("%v", params.GroupRoleId))
+	path = strings.ReplaceAll(path, "{groupId}", pathParam(params.GroupId))
+	path = strings.ReplaceAll(path, "{groupRoleId}", pathParam(params.GroupRoleId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result GroupRoleListResponse
 	req.SetResult(&result)
@@ -2937,7 +3420,7 @@ func (c *Client) UpdateGroupRole(params UpdateGroupRoleParams) (*GroupRoleListRe
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateGroupRole", resp)
 	}
 	return &result, nil
 }
@@ -2947,16 +3430,23 @@ type InviteUserParams struct {
 	UserId string `json:"userId"`
 }
 
-func (c *Client) InviteUser(params InviteUserParams) (*SendNotificationResponse, error) {
+// InviteUser calls InviteUserWithContext with context.Background().
+func (c *Client) InviteUser(params InviteUserParams, body InviteRequest) (*SendNotificationResponse, error) {
+	return c.InviteUserWithContext(context.Background(), params, body)
+}
+
+func (c *Client) InviteUserWithContext(ctx context.Context, params InviteUserParams, body InviteRequest) (*SendNotificationResponse, error) {
 	path := "/invite/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result SendNotificationResponse
 	req.SetResult(&result)
@@ -2969,7 +3459,7 @@ func (c *Client) InviteUser(params InviteUserParams) (*SendNotificationResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("inviteUser", resp)
 	}
 	return &result, nil
 }
@@ -2980,15 +3470,20 @@ type InviteMyselfToParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// InviteMyselfTo calls InviteMyselfToWithContext with context.Background().
 func (c *Client) InviteMyselfTo(params InviteMyselfToParams) (*SendNotificationResponse, error) {
+	return c.InviteMyselfToWithContext(context.Background(), params)
+}
+
+func (c *Client) InviteMyselfToWithContext(ctx context.Context, params InviteMyselfToParams) (*SendNotificationResponse, error) {
 	path := "/invite/myself/to/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3003,7 +3498,7 @@ func (c *Client) InviteMyselfTo(params InviteMyselfToParams) (*SendNotificationR
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("inviteMyselfTo", resp)
 	}
 	return &result, nil
 }
@@ -3013,16 +3508,23 @@ type RequestInviteParams struct {
 	UserId string `json:"userId"`
 }
 
-func (c *Client) RequestInvite(params RequestInviteParams) (*NotificationResponse, error) {
+// RequestInvite calls RequestInviteWithContext with context.Background().
+func (c *Client) RequestInvite(params RequestInviteParams, body RequestInviteRequest) (*NotificationResponse, error) {
+	return c.RequestInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RequestInviteWithContext(ctx context.Context, params RequestInviteParams, body RequestInviteRequest) (*NotificationResponse, error) {
 	path := "/requestInvite/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result NotificationResponse
 	req.SetResult(&result)
@@ -3035,7 +3537,7 @@ func (c *Client) RequestInvite(params RequestInviteParams) (*NotificationRespons
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("requestInvite", resp)
 	}
 	return &result, nil
 }
@@ -3045,16 +3547,23 @@ type RespondInviteParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
-func (c *Client) RespondInvite(params RespondInviteParams) (*NotificationResponse, error) {
+// RespondInvite calls RespondInviteWithContext with context.Background().
+func (c *Client) RespondInvite(params RespondInviteParams, body InviteResponse) (*NotificationResponse, error) {
+	return c.RespondInviteWithContext(context.Background(), params, body)
+}
+
+func (c *Client) RespondInviteWithContext(ctx context.Context, params RespondInviteParams, body InviteResponse) (*NotificationResponse, error) {
 	path := "/invite/{notificationId}/response"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result NotificationResponse
 	req.SetResult(&result)
@@ -3067,7 +3576,7 @@ func (c *Client) RespondInvite(params RespondInviteParams) (*NotificationRespons
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("respondInvite", resp)
 	}
 	return &result, nil
 }
@@ -3078,15 +3587,20 @@ type GetInviteMessagesParams struct {
 	MessageType InviteMessageType `json:"messageType"`
 }
 
+// GetInviteMessages calls GetInviteMessagesWithContext with context.Background().
 func (c *Client) GetInviteMessages(params GetInviteMessagesParams) (*InviteMessageListResponse, error) {
+	return c.GetInviteMessagesWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInviteMessagesWithContext(ctx context.Context, params GetInviteMessagesParams) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{messageType}", fmt.Sprintf("%v", params.MessageType))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{messageType}", pathParam(params.MessageType))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3101,7 +3615,7 @@ func (c *Client) GetInviteMessages(params GetInviteMessagesParams) (*InviteMessa
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getInviteMessages", resp)
 	}
 	return &result, nil
 }
@@ -3113,16 +3627,21 @@ type ResetInviteMessageParams struct {
 	Slot        int64             `json:"slot"`
 }
 
+// ResetInviteMessage calls ResetInviteMessageWithContext with context.Background().
 func (c *Client) ResetInviteMessage(params ResetInviteMessageParams) (*InviteMessageListResponse, error) {
+	return c.ResetInviteMessageWithContext(context.Background(), params)
+}
+
+func (c *Client) ResetInviteMessageWithContext(ctx context.Context, params ResetInviteMessageParams) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{messageType}", fmt.Sprintf("%v", params.MessageType))
-	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{messageType}", pathParam(params.MessageType))
+	path = strings.ReplaceAll(path, "{slot}", pathParam(params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3137,7 +3656,7 @@ func (c *Client) ResetInviteMessage(params ResetInviteMessageParams) (*InviteMes
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("resetInviteMessage", resp)
 	}
 	return &result, nil
 }
@@ -3149,16 +3668,21 @@ type GetInviteMessageParams struct {
 	Slot        int64             `json:"slot"`
 }
 
+// GetInviteMessage calls GetInviteMessageWithContext with context.Background().
 func (c *Client) GetInviteMessage(params GetInviteMessageParams) (*InviteMessageResponse, error) {
+	return c.GetInviteMessageWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInviteMessageWithContext(ctx context.Context, params GetInviteMessageParams) (*InviteMessageResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{messageType}", fmt.Sprintf("%v", params.MessageType))
-	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{messageType}", pathParam(params.MessageType))
+	path = strings.ReplaceAll(path, "{slot}", pathParam(params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3173,7 +3697,7 @@ func (c *Client) GetInviteMessage(params GetInviteMessageParams) (*InviteMessage
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getInviteMessage", resp)
 	}
 	return &result, nil
 }
@@ -3185,18 +3709,25 @@ type UpdateInviteMessageParams struct {
 	Slot        int64             `json:"slot"`
 }
 
-func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams) (*InviteMessageListResponse, error) {
+// UpdateInviteMessage calls UpdateInviteMessageWithContext with context.Background().
+func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams, body UpdateInviteMessageRequest) (*InviteMessageListResponse, error) {
+	return c.UpdateInviteMessageWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateInviteMessageWithContext(ctx context.Context, params UpdateInviteMessageParams, body UpdateInviteMessageRequest) (*InviteMessageListResponse, error) {
 	path := "/message/{userId}/{messageType}/{slot}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
-	path = strings.ReplaceAll(path, "{messageType}", fmt.Sprintf("%v", params.MessageType))
-	path = strings.ReplaceAll(path, "{slot}", fmt.Sprintf("%v", params.Slot))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
+	path = strings.ReplaceAll(path, "{messageType}", pathParam(params.MessageType))
+	path = strings.ReplaceAll(path, "{slot}", pathParam(params.Slot))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result InviteMessageListResponse
 	req.SetResult(&result)
@@ -3209,16 +3740,23 @@ func (c *Client) UpdateInviteMessage(params UpdateInviteMessageParams) (*InviteM
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateInviteMessage", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateInstance() (*InstanceResponse, error) {
+// CreateInstance calls CreateInstanceWithContext with context.Background().
+func (c *Client) CreateInstance(body CreateInstanceRequest) (*InstanceResponse, error) {
+	return c.CreateInstanceWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateInstanceWithContext(ctx context.Context, body CreateInstanceRequest) (*InstanceResponse, error) {
 	path := "/instances"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result InstanceResponse
 	req.SetResult(&result)
@@ -3231,7 +3769,7 @@ func (c *Client) CreateInstance() (*InstanceResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createInstance", resp)
 	}
 	return &result, nil
 }
@@ -3242,15 +3780,20 @@ type CloseInstanceParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// CloseInstance calls CloseInstanceWithContext with context.Background().
 func (c *Client) CloseInstance(params CloseInstanceParams) (*InstanceResponse, error) {
+	return c.CloseInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) CloseInstanceWithContext(ctx context.Context, params CloseInstanceParams) (*InstanceResponse, error) {
 	path := "/instances/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3265,7 +3808,7 @@ func (c *Client) CloseInstance(params CloseInstanceParams) (*InstanceResponse, e
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("closeInstance", resp)
 	}
 	return &result, nil
 }
@@ -3276,15 +3819,20 @@ type GetInstanceParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// GetInstance calls GetInstanceWithContext with context.Background().
 func (c *Client) GetInstance(params GetInstanceParams) (*InstanceResponse, error) {
+	return c.GetInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInstanceWithContext(ctx context.Context, params GetInstanceParams) (*InstanceResponse, error) {
 	path := "/instances/{worldId}:{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3299,7 +3847,7 @@ func (c *Client) GetInstance(params GetInstanceParams) (*InstanceResponse, error
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getInstance", resp)
 	}
 	return &result, nil
 }
@@ -3310,15 +3858,20 @@ type GetShortNameParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// GetShortName calls GetShortNameWithContext with context.Background().
 func (c *Client) GetShortName(params GetShortNameParams) (*InstanceShortNameResponse, error) {
+	return c.GetShortNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetShortNameWithContext(ctx context.Context, params GetShortNameParams) (*InstanceShortNameResponse, error) {
 	path := "/instances/{worldId}:{instanceId}/shortName"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3333,7 +3886,7 @@ func (c *Client) GetShortName(params GetShortNameParams) (*InstanceShortNameResp
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getShortName", resp)
 	}
 	return &result, nil
 }
@@ -3344,15 +3897,20 @@ type SendSelfInviteParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// SendSelfInvite calls SendSelfInviteWithContext with context.Background().
 func (c *Client) SendSelfInvite(params SendSelfInviteParams) (*InstanceSelfInviteSuccess, error) {
+	return c.SendSelfInviteWithContext(context.Background(), params)
+}
+
+func (c *Client) SendSelfInviteWithContext(ctx context.Context, params SendSelfInviteParams) (*InstanceSelfInviteSuccess, error) {
 	path := "/instances/{worldId}:{instanceId}/invite"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3367,16 +3925,31 @@ func (c *Client) SendSelfInvite(params SendSelfInviteParams) (*InstanceSelfInvit
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("sendSelfInvite", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetInstanceByShortName() (*InstanceResponse, error) {
+// GetInstanceByShortNameParams represents the parameters for the GetInstanceByShortName request
+type GetInstanceByShortNameParams struct {
+	ShortName string `json:"shortName"`
+}
+
+// GetInstanceByShortName calls GetInstanceByShortNameWithContext with context.Background().
+func (c *Client) GetInstanceByShortName(params GetInstanceByShortNameParams) (*InstanceResponse, error) {
+	return c.GetInstanceByShortNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInstanceByShortNameWithContext(ctx context.Context, params GetInstanceByShortNameParams) (*InstanceResponse, error) {
 	path := "/instances/s/{shortName}"
+	// Replace path parameters and prepare query parameters
+	queryParams := make(map[string]string)
+	path = strings.ReplaceAll(path, "{shortName}", pathParam(params.ShortName))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set query parameters
+	req.SetQueryParams(queryParams)
 	// Set response object
 	var result InstanceResponse
 	req.SetResult(&result)
@@ -3389,30 +3962,35 @@ func (c *Client) GetInstanceByShortName() (*InstanceResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getInstanceByShortName", resp)
 	}
 	return &result, nil
 }
 
 // GetNotificationsParams represents the parameters for the GetNotifications request
 type GetNotificationsParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      *int64 `json:"n"`
+	Offset *int64 `json:"offset"`
 }
 
+// GetNotifications calls GetNotificationsWithContext with context.Background().
 func (c *Client) GetNotifications(params GetNotificationsParams) (*NotificationListResponse, error) {
+	return c.GetNotificationsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetNotificationsWithContext(ctx context.Context, params GetNotificationsParams) (*NotificationListResponse, error) {
 	path := "/auth/user/notifications"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3427,7 +4005,7 @@ func (c *Client) GetNotifications(params GetNotificationsParams) (*NotificationL
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getNotifications", resp)
 	}
 	return &result, nil
 }
@@ -3437,14 +4015,19 @@ type AcceptFriendRequestParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
+// AcceptFriendRequest calls AcceptFriendRequestWithContext with context.Background().
 func (c *Client) AcceptFriendRequest(params AcceptFriendRequestParams) (*FriendSuccess, error) {
+	return c.AcceptFriendRequestWithContext(context.Background(), params)
+}
+
+func (c *Client) AcceptFriendRequestWithContext(ctx context.Context, params AcceptFriendRequestParams) (*FriendSuccess, error) {
 	path := "/auth/user/notifications/{notificationId}/accept"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3459,7 +4042,7 @@ func (c *Client) AcceptFriendRequest(params AcceptFriendRequestParams) (*FriendS
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("acceptFriendRequest", resp)
 	}
 	return &result, nil
 }
@@ -3469,14 +4052,19 @@ type MarkNotificationAsReadParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
+// MarkNotificationAsRead calls MarkNotificationAsReadWithContext with context.Background().
 func (c *Client) MarkNotificationAsRead(params MarkNotificationAsReadParams) (*NotificationResponse, error) {
+	return c.MarkNotificationAsReadWithContext(context.Background(), params)
+}
+
+func (c *Client) MarkNotificationAsReadWithContext(ctx context.Context, params MarkNotificationAsReadParams) (*NotificationResponse, error) {
 	path := "/auth/user/notifications/{notificationId}/see"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3491,7 +4079,7 @@ func (c *Client) MarkNotificationAsRead(params MarkNotificationAsReadParams) (*N
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("markNotificationAsRead", resp)
 	}
 	return &result, nil
 }
@@ -3501,14 +4089,19 @@ type DeleteNotificationParams struct {
 	NotificationId string `json:"notificationId"`
 }
 
+// DeleteNotification calls DeleteNotificationWithContext with context.Background().
 func (c *Client) DeleteNotification(params DeleteNotificationParams) (*NotificationResponse, error) {
+	return c.DeleteNotificationWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteNotificationWithContext(ctx context.Context, params DeleteNotificationParams) (*NotificationResponse, error) {
 	path := "/auth/user/notifications/{notificationId}/hide"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{notificationId}", fmt.Sprintf("%v", params.NotificationId))
+	path = strings.ReplaceAll(path, "{notificationId}", pathParam(params.NotificationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3523,16 +4116,21 @@ func (c *Client) DeleteNotification(params DeleteNotificationParams) (*Notificat
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deleteNotification", resp)
 	}
 	return &result, nil
 }
 
+// ClearNotifications calls ClearNotificationsWithContext with context.Background().
 func (c *Client) ClearNotifications() (*ClearNotificationsSuccess, error) {
+	return c.ClearNotificationsWithContext(context.Background())
+}
+
+func (c *Client) ClearNotificationsWithContext(ctx context.Context) (*ClearNotificationsSuccess, error) {
 	path := "/auth/user/notifications/clear"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result ClearNotificationsSuccess
 	req.SetResult(&result)
@@ -3545,16 +4143,21 @@ func (c *Client) ClearNotifications() (*ClearNotificationsSuccess, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("clearNotifications", resp)
 	}
 	return &result, nil
 }
 
+// GetAssignedPermissions calls GetAssignedPermissionsWithContext with context.Background().
 func (c *Client) GetAssignedPermissions() (*PermissionListResponse, error) {
+	return c.GetAssignedPermissionsWithContext(context.Background())
+}
+
+func (c *Client) GetAssignedPermissionsWithContext(ctx context.Context) (*PermissionListResponse, error) {
 	path := "/auth/permissions"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result PermissionListResponse
 	req.SetResult(&result)
@@ -3567,7 +4170,7 @@ func (c *Client) GetAssignedPermissions() (*PermissionListResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getAssignedPermissions", resp)
 	}
 	return &result, nil
 }
@@ -3577,14 +4180,19 @@ type GetPermissionParams struct {
 	PermissionId string `json:"permissionId"`
 }
 
+// GetPermission calls GetPermissionWithContext with context.Background().
 func (c *Client) GetPermission(params GetPermissionParams) (*PermissionResponse, error) {
+	return c.GetPermissionWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPermissionWithContext(ctx context.Context, params GetPermissionParams) (*PermissionResponse, error) {
 	path := "/permissions/{permissionId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{permissionId}", fmt.Sprintf("%v", params.PermissionId))
+	path = strings.ReplaceAll(path, "{permissionId}", pathParam(params.PermissionId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3599,16 +4207,21 @@ func (c *Client) GetPermission(params GetPermissionParams) (*PermissionResponse,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getPermission", resp)
 	}
 	return &result, nil
 }
 
+// ClearAllPlayerModerations calls ClearAllPlayerModerationsWithContext with context.Background().
 func (c *Client) ClearAllPlayerModerations() (*PlayerModerationClearAllSuccess, error) {
+	return c.ClearAllPlayerModerationsWithContext(context.Background())
+}
+
+func (c *Client) ClearAllPlayerModerationsWithContext(ctx context.Context) (*PlayerModerationClearAllSuccess, error) {
 	path := "/auth/user/playermoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result PlayerModerationClearAllSuccess
 	req.SetResult(&result)
@@ -3621,16 +4234,37 @@ func (c *Client) ClearAllPlayerModerations() (*PlayerModerationClearAllSuccess,
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("clearAllPlayerModerations", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetPlayerModerations() (*PlayerModerationListResponse, error) {
+// GetPlayerModerationsParams represents the parameters for the GetPlayerModerations request
+type GetPlayerModerationsParams struct {
+	Type         *PlayerModerationType `json:"type"`
+	TargetUserId *string               `json:"targetUserId"`
+}
+
+// GetPlayerModerations calls GetPlayerModerationsWithContext with context.Background().
+func (c *Client) GetPlayerModerations(params GetPlayerModerationsParams) (*PlayerModerationListResponse, error) {
+	return c.GetPlayerModerationsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPlayerModerationsWithContext(ctx context.Context, params GetPlayerModerationsParams) (*PlayerModerationListResponse, error) {
 	path := "/auth/user/playermoderations"
+	// Replace path parameters and prepare query parameters
+	queryParams := make(map[string]string)
+	if params.Type != nil {
+		queryParams["type"] = fmt.Sprintf("%v", *params.Type)
+	}
+	if params.TargetUserId != nil {
+		queryParams["targetUserId"] = fmt.Sprintf("%v", *params.TargetUserId)
+	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set query parameters
+	req.SetQueryParams(queryParams)
 	// Set response object
 	var result PlayerModerationListResponse
 	req.SetResult(&result)
@@ -3643,16 +4277,23 @@ func (c *Client) GetPlayerModerations() (*PlayerModerationListResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getPlayerModerations", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) ModerateUser() (*PlayerModerationResponse, error) {
+// ModerateUser calls ModerateUserWithContext with context.Background().
+func (c *Client) ModerateUser(body ModerateUserRequest) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(context.Background(), body)
+}
+
+func (c *Client) ModerateUserWithContext(ctx context.Context, body ModerateUserRequest) (*PlayerModerationResponse, error) {
 	path := "/auth/user/playermoderations"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result PlayerModerationResponse
 	req.SetResult(&result)
@@ -3665,16 +4306,31 @@ func (c *Client) ModerateUser() (*PlayerModerationResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("moderateUser", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) DeletePlayerModeration() (*PlayerModerationRemovedSuccess, error) {
+// DeletePlayerModerationParams represents the parameters for the DeletePlayerModeration request
+type DeletePlayerModerationParams struct {
+	PlayerModerationId string `json:"playerModerationId"`
+}
+
+// DeletePlayerModeration calls DeletePlayerModerationWithContext with context.Background().
+func (c *Client) DeletePlayerModeration(params DeletePlayerModerationParams) (*PlayerModerationRemovedSuccess, error) {
+	return c.DeletePlayerModerationWithContext(context.Background(), params)
+}
+
+func (c *Client) DeletePlayerModerationWithContext(ctx context.Context, params DeletePlayerModerationParams) (*PlayerModerationRemovedSuccess, error) {
 	path := "/auth/user/playermoderations/{playerModerationId}"
+	// Replace path parameters and prepare query parameters
+	queryParams := make(map[string]string)
+	path = strings.ReplaceAll(path, "{playerModerationId}", pathParam(params.PlayerModerationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set query parameters
+	req.SetQueryParams(queryParams)
 	// Set response object
 	var result PlayerModerationRemovedSuccess
 	req.SetResult(&result)
@@ -3687,16 +4343,31 @@ func (c *Client) DeletePlayerModeration() (*PlayerModerationRemovedSuccess, erro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("deletePlayerModeration", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetPlayerModeration() (*PlayerModerationResponse, error) {
+// GetPlayerModerationParams represents the parameters for the GetPlayerModeration request
+type GetPlayerModerationParams struct {
+	PlayerModerationId string `json:"playerModerationId"`
+}
+
+// GetPlayerModeration calls GetPlayerModerationWithContext with context.Background().
+func (c *Client) GetPlayerModeration(params GetPlayerModerationParams) (*PlayerModerationResponse, error) {
+	return c.GetPlayerModerationWithContext(context.Background(), params)
+}
+
+func (c *Client) GetPlayerModerationWithContext(ctx context.Context, params GetPlayerModerationParams) (*PlayerModerationResponse, error) {
 	path := "/auth/user/playermoderations/{playerModerationId}"
+	// Replace path parameters and prepare query parameters
+	queryParams := make(map[string]string)
+	path = strings.ReplaceAll(path, "{playerModerationId}", pathParam(params.PlayerModerationId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set query parameters
+	req.SetQueryParams(queryParams)
 	// Set response object
 	var result PlayerModerationResponse
 	req.SetResult(&result)
@@ -3709,16 +4380,23 @@ func (c *Client) GetPlayerModeration() (*PlayerModerationResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getPlayerModeration", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) UnmoderateUser() (*PlayerModerationUnmoderatedSuccess, error) {
+// UnmoderateUser calls UnmoderateUserWithContext with context.Background().
+func (c *Client) UnmoderateUser(body ModerateUserRequest) (*PlayerModerationUnmoderatedSuccess, error) {
+	return c.UnmoderateUserWithContext(context.Background(), body)
+}
+
+func (c *Client) UnmoderateUserWithContext(ctx context.Context, body ModerateUserRequest) (*PlayerModerationUnmoderatedSuccess, error) {
 	path := "/auth/user/unplayermoderate"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result PlayerModerationUnmoderatedSuccess
 	req.SetResult(&result)
@@ -3731,16 +4409,21 @@ func (c *Client) UnmoderateUser() (*PlayerModerationUnmoderatedSuccess, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("unmoderateUser", resp)
 	}
 	return &result, nil
 }
 
+// GetConfig calls GetConfigWithContext with context.Background().
 func (c *Client) GetConfig() (*ApiConfigResponse, error) {
+	return c.GetConfigWithContext(context.Background())
+}
+
+func (c *Client) GetConfigWithContext(ctx context.Context) (*ApiConfigResponse, error) {
 	path := "/config"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result ApiConfigResponse
 	req.SetResult(&result)
@@ -3753,30 +4436,35 @@ func (c *Client) GetConfig() (*ApiConfigResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getConfig", resp)
 	}
 	return &result, nil
 }
 
 // GetInfoPushParams represents the parameters for the GetInfoPush request
 type GetInfoPushParams struct {
-	Require string `json:"require"`
-	Include string `json:"include"`
+	Require *string `json:"require"`
+	Include *string `json:"include"`
 }
 
+// GetInfoPush calls GetInfoPushWithContext with context.Background().
 func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, error) {
+	return c.GetInfoPushWithContext(context.Background(), params)
+}
+
+func (c *Client) GetInfoPushWithContext(ctx context.Context, params GetInfoPushParams) (*InfoPushListResponse, error) {
 	path := "/infoPush"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Require) {
-		queryParams["require"] = fmt.Sprintf("%v", params.Require)
+	if params.Require != nil {
+		queryParams["require"] = fmt.Sprintf("%v", *params.Require)
 	}
-	if lo.IsNotEmpty(params.Include) {
-		queryParams["include"] = fmt.Sprintf("%v", params.Include)
+	if params.Include != nil {
+		queryParams["include"] = fmt.Sprintf("%v", *params.Include)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3791,7 +4479,7 @@ func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, e
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getInfoPush", resp)
 	}
 	return &result, nil
 }
@@ -3799,23 +4487,28 @@ func (c *Client) GetInfoPush(params GetInfoPushParams) (*InfoPushListResponse, e
 // GetCssParams represents the parameters for the GetCss request
 type GetCssParams struct {
 	// Variant enum
-	Variant string `json:"variant"`
-	Branch  string `json:"branch"`
+	Variant *string `json:"variant"`
+	Branch  *string `json:"branch"`
 }
 
+// GetCss calls GetCssWithContext with context.Background().
 func (c *Client) GetCss(params GetCssParams) error {
+	return c.GetCssWithContext(context.Background(), params)
+}
+
+func (c *Client) GetCssWithContext(ctx context.Context, params GetCssParams) error {
 	path := "/css/app.css"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Variant) {
-		queryParams["variant"] = fmt.Sprintf("%v", params.Variant)
+	if params.Variant != nil {
+		queryParams["variant"] = fmt.Sprintf("%v", *params.Variant)
 	}
-	if lo.IsNotEmpty(params.Branch) {
-		queryParams["branch"] = fmt.Sprintf("%v", params.Branch)
+	if params.Branch != nil {
+		queryParams["branch"] = fmt.Sprintf("%v", *params.Branch)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -3827,7 +4520,7 @@ func (c *Client) GetCss(params GetCssParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("getCSS", resp)
 	}
 	return nil
 }
@@ -3835,23 +4528,28 @@ func (c *Client) GetCss(params GetCssParams) error {
 // GetJavaScriptParams represents the parameters for the GetJavaScript request
 type GetJavaScriptParams struct {
 	// Variant enum
-	Variant string `json:"variant"`
-	Branch  string `json:"branch"`
+	Variant *string `json:"variant"`
+	Branch  *string `json:"branch"`
 }
 
+// GetJavaScript calls GetJavaScriptWithContext with context.Background().
 func (c *Client) GetJavaScript(params GetJavaScriptParams) error {
+	return c.GetJavaScriptWithContext(context.Background(), params)
+}
+
+func (c *Client) GetJavaScriptWithContext(ctx context.Context, params GetJavaScriptParams) error {
 	path := "/js/app.js"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Variant) {
-		queryParams["variant"] = fmt.Sprintf("%v", params.Variant)
+	if params.Variant != nil {
+		queryParams["variant"] = fmt.Sprintf("%v", *params.Variant)
 	}
-	if lo.IsNotEmpty(params.Branch) {
-		queryParams["branch"] = fmt.Sprintf("%v", params.Branch)
+	if params.Branch != nil {
+		queryParams["branch"] = fmt.Sprintf("%v", *params.Branch)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -3863,16 +4561,21 @@ func (c *Client) GetJavaScript(params GetJavaScriptParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("getJavaScript", resp)
 	}
 	return nil
 }
 
+// GetHealth calls GetHealthWithContext with context.Background().
 func (c *Client) GetHealth() (*ApiHealthResponse, error) {
+	return c.GetHealthWithContext(context.Background())
+}
+
+func (c *Client) GetHealthWithContext(ctx context.Context) (*ApiHealthResponse, error) {
 	path := "/health"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result ApiHealthResponse
 	req.SetResult(&result)
@@ -3885,16 +4588,21 @@ func (c *Client) GetHealth() (*ApiHealthResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getHealth", resp)
 	}
 	return &result, nil
 }
 
+// GetCurrentOnlineUsers calls GetCurrentOnlineUsersWithContext with context.Background().
 func (c *Client) GetCurrentOnlineUsers() (*CurrentOnlineUsersResponse, error) {
+	return c.GetCurrentOnlineUsersWithContext(context.Background())
+}
+
+func (c *Client) GetCurrentOnlineUsersWithContext(ctx context.Context) (*CurrentOnlineUsersResponse, error) {
 	path := "/visits"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result CurrentOnlineUsersResponse
 	req.SetResult(&result)
@@ -3907,16 +4615,21 @@ func (c *Client) GetCurrentOnlineUsers() (*CurrentOnlineUsersResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getCurrentOnlineUsers", resp)
 	}
 	return &result, nil
 }
 
+// GetSystemTime calls GetSystemTimeWithContext with context.Background().
 func (c *Client) GetSystemTime() (*SystemTimeResponse, error) {
+	return c.GetSystemTimeWithContext(context.Background())
+}
+
+func (c *Client) GetSystemTimeWithContext(ctx context.Context) (*SystemTimeResponse, error) {
 	path := "/time"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set response object
 	var result SystemTimeResponse
 	req.SetResult(&result)
@@ -3929,30 +4642,35 @@ func (c *Client) GetSystemTime() (*SystemTimeResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getSystemTime", resp)
 	}
 	return &result, nil
 }
 
 // SearchUsersParams represents the parameters for the SearchUsers request
 type SearchUsersParams struct {
-	N      int64 `json:"n"`
-	Offset int64 `json:"offset"`
+	N      *int64 `json:"n"`
+	Offset *int64 `json:"offset"`
 }
 
+// SearchUsers calls SearchUsersWithContext with context.Background().
 func (c *Client) SearchUsers(params SearchUsersParams) (*LimitedUserListResponse, error) {
+	return c.SearchUsersWithContext(context.Background(), params)
+}
+
+func (c *Client) SearchUsersWithContext(ctx context.Context, params SearchUsersParams) (*LimitedUserListResponse, error) {
 	path := "/users"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -3967,16 +4685,31 @@ func (c *Client) SearchUsers(params SearchUsersParams) (*LimitedUserListResponse
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("searchUsers", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) GetUserByName() (*UserResponse, error) {
+// GetUserByNameParams represents the parameters for the GetUserByName request
+type GetUserByNameParams struct {
+	Username string `json:"username"`
+}
+
+// GetUserByName calls GetUserByNameWithContext with context.Background().
+func (c *Client) GetUserByName(params GetUserByNameParams) (*UserResponse, error) {
+	return c.GetUserByNameWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserByNameWithContext(ctx context.Context, params GetUserByNameParams) (*UserResponse, error) {
 	path := "/users/{username}/name"
+	// Replace path parameters and prepare query parameters
+	queryParams := make(map[string]string)
+	path = strings.ReplaceAll(path, "{username}", pathParam(params.Username))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set query parameters
+	req.SetQueryParams(queryParams)
 	// Set response object
 	var result UserResponse
 	req.SetResult(&result)
@@ -3989,7 +4722,7 @@ func (c *Client) GetUserByName() (*UserResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getUserByName", resp)
 	}
 	return &result, nil
 }
@@ -3999,14 +4732,19 @@ type GetUserParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetUser calls GetUserWithContext with context.Background().
 func (c *Client) GetUser(params GetUserParams) (*UserResponse, error) {
+	return c.GetUserWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserWithContext(ctx context.Context, params GetUserParams) (*UserResponse, error) {
 	path := "/users/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4021,7 +4759,7 @@ func (c *Client) GetUser(params GetUserParams) (*UserResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getUser", resp)
 	}
 	return &result, nil
 }
@@ -4031,16 +4769,23 @@ type UpdateUserParams struct {
 	UserId string `json:"userId"`
 }
 
-func (c *Client) UpdateUser(params UpdateUserParams) (*CurrentUserResponse, error) {
+// UpdateUser calls UpdateUserWithContext with context.Background().
+func (c *Client) UpdateUser(params UpdateUserParams, body UpdateUserRequest) (*CurrentUserResponse, error) {
+	return c.UpdateUserWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateUserWithContext(ctx context.Context, params UpdateUserParams, body UpdateUserRequest) (*CurrentUserResponse, error) {
 	path := "/users/{userId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result CurrentUserResponse
 	req.SetResult(&result)
@@ -4053,7 +4798,7 @@ func (c *Client) UpdateUser(params UpdateUserParams) (*CurrentUserResponse, erro
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateUser", resp)
 	}
 	return &result, nil
 }
@@ -4063,14 +4808,19 @@ type GetUserGroupsParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetUserGroups calls GetUserGroupsWithContext with context.Background().
 func (c *Client) GetUserGroups(params GetUserGroupsParams) (*LimitedUserGroupListResponse, error) {
+	return c.GetUserGroupsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserGroupsWithContext(ctx context.Context, params GetUserGroupsParams) (*LimitedUserGroupListResponse, error) {
 	path := "/users/{userId}/groups"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4085,7 +4835,7 @@ func (c *Client) GetUserGroups(params GetUserGroupsParams) (*LimitedUserGroupLis
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getUserGroups", resp)
 	}
 	return &result, nil
 }
@@ -4095,14 +4845,19 @@ type GetUserGroupRequestsParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetUserGroupRequests calls GetUserGroupRequestsWithContext with context.Background().
 func (c *Client) GetUserGroupRequests(params GetUserGroupRequestsParams) (*GroupListResponse, error) {
+	return c.GetUserGroupRequestsWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserGroupRequestsWithContext(ctx context.Context, params GetUserGroupRequestsParams) (*GroupListResponse, error) {
 	path := "/users/{userId}/groups/requested"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4117,7 +4872,7 @@ func (c *Client) GetUserGroupRequests(params GetUserGroupRequestsParams) (*Group
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getUserGroupRequests", resp)
 	}
 	return &result, nil
 }
@@ -4127,14 +4882,19 @@ type GetUserRepresentedGroupParams struct {
 	UserId string `json:"userId"`
 }
 
+// GetUserRepresentedGroup calls GetUserRepresentedGroupWithContext with context.Background().
 func (c *Client) GetUserRepresentedGroup(params GetUserRepresentedGroupParams) error {
+	return c.GetUserRepresentedGroupWithContext(context.Background(), params)
+}
+
+func (c *Client) GetUserRepresentedGroupWithContext(ctx context.Context, params GetUserRepresentedGroupParams) error {
 	path := "/users/{userId}/groups/represented"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{userId}", fmt.Sprintf("%v", params.UserId))
+	path = strings.ReplaceAll(path, "{userId}", pathParam(params.UserId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -4146,78 +4906,83 @@ func (c *Client) GetUserRepresentedGroup(params GetUserRepresentedGroupParams) e
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("getUserRepresentedGroup", resp)
 	}
 	return nil
 }
 
 // SearchWorldsParams represents the parameters for the SearchWorlds request
 type SearchWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	UserId          string        `json:"userId"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
-	Fuzzy           bool          `json:"fuzzy"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	UserId          *string        `json:"userId"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Search          *string        `json:"search"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+	Fuzzy           *bool          `json:"fuzzy"`
+}
+
+// SearchWorlds calls SearchWorldsWithContext with context.Background().
+func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.SearchWorldsWithContext(context.Background(), params)
 }
 
-func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListResponse, error) {
+func (c *Client) SearchWorldsWithContext(ctx context.Context, params SearchWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.UserId) {
-		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
+	if params.UserId != nil {
+		queryParams["userId"] = fmt.Sprintf("%v", *params.UserId)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Search) {
-		queryParams["search"] = fmt.Sprintf("%v", params.Search)
+	if params.Search != nil {
+		queryParams["search"] = fmt.Sprintf("%v", *params.Search)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
-	if lo.IsNotEmpty(params.Fuzzy) {
-		queryParams["fuzzy"] = fmt.Sprintf("%v", params.Fuzzy)
+	if params.Fuzzy != nil {
+		queryParams["fuzzy"] = fmt.Sprintf("%v", *params.Fuzzy)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4232,16 +4997,23 @@ func (c *Client) SearchWorlds(params SearchWorldsParams) (*LimitedWorldListRespo
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("searchWorlds", resp)
 	}
 	return &result, nil
 }
 
-func (c *Client) CreateWorld() (*WorldResponse, error) {
+// CreateWorld calls CreateWorldWithContext with context.Background().
+func (c *Client) CreateWorld(body CreateWorldRequest) (*WorldResponse, error) {
+	return c.CreateWorldWithContext(context.Background(), body)
+}
+
+func (c *Client) CreateWorldWithContext(ctx context.Context, body CreateWorldRequest) (*WorldResponse, error) {
 	path := "/worlds"
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result WorldResponse
 	req.SetResult(&result)
@@ -4254,70 +5026,75 @@ func (c *Client) CreateWorld() (*WorldResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("createWorld", resp)
 	}
 	return &result, nil
 }
 
 // GetActiveWorldsParams represents the parameters for the GetActiveWorlds request
 type GetActiveWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Search          *string        `json:"search"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+}
+
+// GetActiveWorlds calls GetActiveWorldsWithContext with context.Background().
+func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.GetActiveWorldsWithContext(context.Background(), params)
 }
 
-func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldListResponse, error) {
+func (c *Client) GetActiveWorldsWithContext(ctx context.Context, params GetActiveWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds/active"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Search) {
-		queryParams["search"] = fmt.Sprintf("%v", params.Search)
+	if params.Search != nil {
+		queryParams["search"] = fmt.Sprintf("%v", *params.Search)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4332,74 +5109,79 @@ func (c *Client) GetActiveWorlds(params GetActiveWorldsParams) (*LimitedWorldLis
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getActiveWorlds", resp)
 	}
 	return &result, nil
 }
 
 // GetFavoritedWorldsParams represents the parameters for the GetFavoritedWorlds request
 type GetFavoritedWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
-	UserId          string        `json:"userId"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Search          *string        `json:"search"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+	UserId          *string        `json:"userId"`
+}
+
+// GetFavoritedWorlds calls GetFavoritedWorldsWithContext with context.Background().
+func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.GetFavoritedWorldsWithContext(context.Background(), params)
 }
 
-func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*LimitedWorldListResponse, error) {
+func (c *Client) GetFavoritedWorldsWithContext(ctx context.Context, params GetFavoritedWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds/favorites"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Search) {
-		queryParams["search"] = fmt.Sprintf("%v", params.Search)
+	if params.Search != nil {
+		queryParams["search"] = fmt.Sprintf("%v", *params.Search)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
-	if lo.IsNotEmpty(params.UserId) {
-		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
+	if params.UserId != nil {
+		queryParams["userId"] = fmt.Sprintf("%v", *params.UserId)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4414,74 +5196,79 @@ func (c *Client) GetFavoritedWorlds(params GetFavoritedWorldsParams) (*LimitedWo
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getFavoritedWorlds", resp)
 	}
 	return &result, nil
 }
 
 // GetRecentWorldsParams represents the parameters for the GetRecentWorlds request
 type GetRecentWorldsParams struct {
-	Featured        bool          `json:"featured"`
-	Sort            SortOption    `json:"sort"`
-	N               int64         `json:"n"`
-	Order           OrderOption   `json:"order"`
-	Offset          int64         `json:"offset"`
-	Search          string        `json:"search"`
-	Tag             string        `json:"tag"`
-	Notag           string        `json:"notag"`
-	ReleaseStatus   ReleaseStatus `json:"releaseStatus"`
-	MaxUnityVersion string        `json:"maxUnityVersion"`
-	MinUnityVersion string        `json:"minUnityVersion"`
-	Platform        string        `json:"platform"`
-	UserId          string        `json:"userId"`
+	Featured        *bool          `json:"featured"`
+	Sort            *SortOption    `json:"sort"`
+	N               *int64         `json:"n"`
+	Order           *OrderOption   `json:"order"`
+	Offset          *int64         `json:"offset"`
+	Search          *string        `json:"search"`
+	Tag             *string        `json:"tag"`
+	Notag           *string        `json:"notag"`
+	ReleaseStatus   *ReleaseStatus `json:"releaseStatus"`
+	MaxUnityVersion *string        `json:"maxUnityVersion"`
+	MinUnityVersion *string        `json:"minUnityVersion"`
+	Platform        *string        `json:"platform"`
+	UserId          *string        `json:"userId"`
+}
+
+// GetRecentWorlds calls GetRecentWorldsWithContext with context.Background().
+func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldListResponse, error) {
+	return c.GetRecentWorldsWithContext(context.Background(), params)
 }
 
-func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldListResponse, error) {
+func (c *Client) GetRecentWorldsWithContext(ctx context.Context, params GetRecentWorldsParams) (*LimitedWorldListResponse, error) {
 	path := "/worlds/recent"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	if lo.IsNotEmpty(params.Featured) {
-		queryParams["featured"] = fmt.Sprintf("%v", params.Featured)
+	if params.Featured != nil {
+		queryParams["featured"] = fmt.Sprintf("%v", *params.Featured)
 	}
-	if lo.IsNotEmpty(params.Sort) {
-		queryParams["sort"] = fmt.Sprintf("%v", params.Sort)
+	if params.Sort != nil {
+		queryParams["sort"] = fmt.Sprintf("%v", *params.Sort)
 	}
-	if lo.IsNotEmpty(params.N) {
-		queryParams["n"] = fmt.Sprintf("%v", params.N)
+	if params.N != nil {
+		queryParams["n"] = fmt.Sprintf("%v", *params.N)
 	}
-	if lo.IsNotEmpty(params.Order) {
-		queryParams["order"] = fmt.Sprintf("%v", params.Order)
+	if params.Order != nil {
+		queryParams["order"] = fmt.Sprintf("%v", *params.Order)
 	}
-	if lo.IsNotEmpty(params.Offset) {
-		queryParams["offset"] = fmt.Sprintf("%v", params.Offset)
+	if params.Offset != nil {
+		queryParams["offset"] = fmt.Sprintf("%v", *params.Offset)
 	}
-	if lo.IsNotEmpty(params.Search) {
-		queryParams["search"] = fmt.Sprintf("%v", params.Search)
+	if params.Search != nil {
+		queryParams["search"] = fmt.Sprintf("%v", *params.Search)
 	}
-	if lo.IsNotEmpty(params.Tag) {
-		queryParams["tag"] = fmt.Sprintf("%v", params.Tag)
+	if params.Tag != nil {
+		queryParams["tag"] = fmt.Sprintf("%v", *params.Tag)
 	}
-	if lo.IsNotEmpty(params.Notag) {
-		queryParams["notag"] = fmt.Sprintf("%v", params.Notag)
+	if params.Notag != nil {
+		queryParams["notag"] = fmt.Sprintf("%v", *params.Notag)
 	}
-	if lo.IsNotEmpty(params.ReleaseStatus) {
-		queryParams["releaseStatus"] = fmt.Sprintf("%v", params.ReleaseStatus)
+	if params.ReleaseStatus != nil {
+		queryParams["releaseStatus"] = fmt.Sprintf("%v", *params.ReleaseStatus)
 	}
-	if lo.IsNotEmpty(params.MaxUnityVersion) {
-		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", params.MaxUnityVersion)
+	if params.MaxUnityVersion != nil {
+		queryParams["maxUnityVersion"] = fmt.Sprintf("%v", *params.MaxUnityVersion)
 	}
-	if lo.IsNotEmpty(params.MinUnityVersion) {
-		queryParams["minUnityVersion"] = fmt.Sprintf("%v", params.MinUnityVersion)
+	if params.MinUnityVersion != nil {
+		queryParams["minUnityVersion"] = fmt.Sprintf("%v", *params.MinUnityVersion)
 	}
-	if lo.IsNotEmpty(params.Platform) {
-		queryParams["platform"] = fmt.Sprintf("%v", params.Platform)
+	if params.Platform != nil {
+		queryParams["platform"] = fmt.Sprintf("%v", *params.Platform)
 	}
-	if lo.IsNotEmpty(params.UserId) {
-		queryParams["userId"] = fmt.Sprintf("%v", params.UserId)
+	if params.UserId != nil {
+		queryParams["userId"] = fmt.Sprintf("%v", *params.UserId)
 	}
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4496,7 +5283,7 @@ func (c *Client) GetRecentWorlds(params GetRecentWorldsParams) (*LimitedWorldLis
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getRecentWorlds", resp)
 	}
 	return &result, nil
 }
@@ -4506,14 +5293,19 @@ type DeleteWorldParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// DeleteWorld calls DeleteWorldWithContext with context.Background().
 func (c *Client) DeleteWorld(params DeleteWorldParams) error {
+	return c.DeleteWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) DeleteWorldWithContext(ctx context.Context, params DeleteWorldParams) error {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -4525,7 +5317,7 @@ func (c *Client) DeleteWorld(params DeleteWorldParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("deleteWorld", resp)
 	}
 	return nil
 }
@@ -4535,14 +5327,19 @@ type GetWorldParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// GetWorld calls GetWorldWithContext with context.Background().
 func (c *Client) GetWorld(params GetWorldParams) (*WorldResponse, error) {
+	return c.GetWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldWithContext(ctx context.Context, params GetWorldParams) (*WorldResponse, error) {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4557,7 +5354,7 @@ func (c *Client) GetWorld(params GetWorldParams) (*WorldResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getWorld", resp)
 	}
 	return &result, nil
 }
@@ -4567,16 +5364,23 @@ type UpdateWorldParams struct {
 	WorldId string `json:"worldId"`
 }
 
-func (c *Client) UpdateWorld(params UpdateWorldParams) (*WorldResponse, error) {
+// UpdateWorld calls UpdateWorldWithContext with context.Background().
+func (c *Client) UpdateWorld(params UpdateWorldParams, body UpdateWorldRequest) (*WorldResponse, error) {
+	return c.UpdateWorldWithContext(context.Background(), params, body)
+}
+
+func (c *Client) UpdateWorldWithContext(ctx context.Context, params UpdateWorldParams, body UpdateWorldRequest) (*WorldResponse, error) {
 	path := "/worlds/{worldId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
+	// Set request body
+	req.SetBody(body)
 	// Set response object
 	var result WorldResponse
 	req.SetResult(&result)
@@ -4589,7 +5393,7 @@ func (c *Client) UpdateWorld(params UpdateWorldParams) (*WorldResponse, error) {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("updateWorld", resp)
 	}
 	return &result, nil
 }
@@ -4599,14 +5403,19 @@ type GetWorldMetadataParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// GetWorldMetadata calls GetWorldMetadataWithContext with context.Background().
 func (c *Client) GetWorldMetadata(params GetWorldMetadataParams) (*WorldMetadataResponse, error) {
+	return c.GetWorldMetadataWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldMetadataWithContext(ctx context.Context, params GetWorldMetadataParams) (*WorldMetadataResponse, error) {
 	path := "/worlds/{worldId}/metadata"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4621,7 +5430,7 @@ func (c *Client) GetWorldMetadata(params GetWorldMetadataParams) (*WorldMetadata
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getWorldMetadata", resp)
 	}
 	return &result, nil
 }
@@ -4631,14 +5440,19 @@ type UnpublishWorldParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// UnpublishWorld calls UnpublishWorldWithContext with context.Background().
 func (c *Client) UnpublishWorld(params UnpublishWorldParams) error {
+	return c.UnpublishWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) UnpublishWorldWithContext(ctx context.Context, params UnpublishWorldParams) error {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -4650,7 +5464,7 @@ func (c *Client) UnpublishWorld(params UnpublishWorldParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("unpublishWorld", resp)
 	}
 	return nil
 }
@@ -4660,14 +5474,19 @@ type GetWorldPublishStatusParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// GetWorldPublishStatus calls GetWorldPublishStatusWithContext with context.Background().
 func (c *Client) GetWorldPublishStatus(params GetWorldPublishStatusParams) (*WorldPublishStatusResponse, error) {
+	return c.GetWorldPublishStatusWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldPublishStatusWithContext(ctx context.Context, params GetWorldPublishStatusParams) (*WorldPublishStatusResponse, error) {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4682,7 +5501,7 @@ func (c *Client) GetWorldPublishStatus(params GetWorldPublishStatusParams) (*Wor
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getWorldPublishStatus", resp)
 	}
 	return &result, nil
 }
@@ -4692,14 +5511,19 @@ type PublishWorldParams struct {
 	WorldId string `json:"worldId"`
 }
 
+// PublishWorld calls PublishWorldWithContext with context.Background().
 func (c *Client) PublishWorld(params PublishWorldParams) error {
+	return c.PublishWorldWithContext(context.Background(), params)
+}
+
+func (c *Client) PublishWorldWithContext(ctx context.Context, params PublishWorldParams) error {
 	path := "/worlds/{worldId}/publish"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 
@@ -4711,7 +5535,7 @@ func (c *Client) PublishWorld(params PublishWorldParams) error {
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return newAPIError("publishWorld", resp)
 	}
 	return nil
 }
@@ -4722,15 +5546,20 @@ type GetWorldInstanceParams struct {
 	InstanceId string `json:"instanceId"`
 }
 
+// GetWorldInstance calls GetWorldInstanceWithContext with context.Background().
 func (c *Client) GetWorldInstance(params GetWorldInstanceParams) (*InstanceResponse, error) {
+	return c.GetWorldInstanceWithContext(context.Background(), params)
+}
+
+func (c *Client) GetWorldInstanceWithContext(ctx context.Context, params GetWorldInstanceParams) (*InstanceResponse, error) {
 	path := "/worlds/{worldId}/{instanceId}"
 	// Replace path parameters and prepare query parameters
 	queryParams := make(map[string]string)
-	path = strings.ReplaceAll(path, "{worldId}", fmt.Sprintf("%v", params.WorldId))
-	path = strings.ReplaceAll(path, "{instanceId}", fmt.Sprintf("%v", params.InstanceId))
+	path = strings.ReplaceAll(path, "{worldId}", pathParam(params.WorldId))
+	path = strings.ReplaceAll(path, "{instanceId}", pathParam(params.InstanceId))
 
 	// Create request
-	req := c.client.R()
+	req := c.client.R().SetContext(ctx)
 	// Set query parameters
 	req.SetQueryParams(queryParams)
 	// Set response object
@@ -4745,7 +5574,7 @@ func (c *Client) GetWorldInstance(params GetWorldInstanceParams) (*InstanceRespo
 
 	// Check for successful status code
 	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
-		return nil, fmt.Errorf("unexpected status code: %d, body: %s", resp.StatusCode(), resp.String())
+		return nil, newAPIError("getWorldInstance", resp)
 	}
 	return &result, nil
 }
@@ -0,0 +1,99 @@
+package vrchat
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DefaultUserAgent is sent with every request unless overridden by WithUserAgent.
+// VRChat asks third-party applications to identify themselves, so callers
+// should set their own application name and contact information.
+const DefaultUserAgent = "vrchat-go/0.0.0 mayo@linux.com"
+
+// Client is a VRChat API client.
+type Client struct {
+	client *resty.Client
+}
+
+// NewClient creates a new client for the API at baseURL, configured by opts.
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	o := clientOptions{
+		userAgent: DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var rc *resty.Client
+	if o.httpClient != nil {
+		// Copy the client and its transport so that options and the cookie jar
+		// don't leak into the caller's client
+		hc := *o.httpClient
+		if t, ok := hc.Transport.(*http.Transport); ok {
+			hc.Transport = t.Clone()
+		}
+		if hc.Jar == nil {
+			hc.Jar, _ = cookiejar.New(nil)
+		}
+		rc = resty.NewWithClient(&hc)
+	} else {
+		rc = resty.New()
+	}
+	rc.SetBaseURL(baseURL).
+		SetHeaders(map[string]string{
+			"User-Agent":   o.userAgent,
+			"Accept":       "application/json",
+			"Content-Type": "application/json",
+		}).
+		SetHeaders(o.headers).
+		SetDebug(o.debug)
+	if o.timeout > 0 {
+		rc.SetTimeout(o.timeout)
+	}
+	if o.proxy != "" {
+		rc.SetProxy(o.proxy)
+	}
+	if o.retryPolicy != nil {
+		o.retryPolicy.apply(rc)
+	}
+	if o.rateLimiter != nil {
+		rc.OnBeforeRequest(rateLimitMiddleware(o.rateLimiter))
+	}
+
+	c := &Client{
+		client: rc,
+	}
+	if o.credentials != nil {
+		hc := rc.GetClient()
+		hc.Transport = &reauthTransport{
+			base:        hc.Transport,
+			client:      c,
+			credentials: o.credentials,
+		}
+	}
+	return c
+}
+
+// baseURL returns the parsed base URL of the API, or nil if it is invalid.
+func (c *Client) baseURL() *url.URL {
+	u, err := url.Parse(c.client.BaseURL)
+	if err != nil {
+		return nil
+	}
+	return u
+}
+
+// pathParam escapes a path parameter so that it stays a single path segment,
+// including the dot segments "." and "..".
+func pathParam(v any) string {
+	s := url.PathEscape(fmt.Sprint(v))
+	if strings.Trim(s, ".") == "" {
+		s = strings.ReplaceAll(s, ".", "%2E")
+	}
+	return s
+}
@@ -0,0 +1,43 @@
+package vrchat
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewClientDoesNotModifyHTTPClient(t *testing.T) {
+	transport := &http.Transport{}
+	hc := &http.Client{Transport: transport}
+
+	c := NewClient("http://localhost", WithHTTPClient(hc), WithProxy("http://127.0.0.1:8080"), WithTimeout(5*time.Second))
+
+	if transport.Proxy != nil {
+		t.Error("proxy was set on the caller's transport")
+	}
+	if hc.Jar != nil || hc.Timeout != 0 {
+		t.Error("cookie jar or timeout was set on the caller's client")
+	}
+	got, ok := c.client.GetClient().Transport.(*http.Transport)
+	if !ok || got == transport || got.Proxy == nil {
+		t.Error("proxy was not set on a copy of the transport")
+	}
+}
+
+func TestPathParam(t *testing.T) {
+	tests := map[any]string{
+		"usr_xxx":              "usr_xxx",
+		"wrld_xxx":             "wrld_xxx",
+		"12345~region(eu)":     "12345~region%28eu%29",
+		"../auth/user?x=1#top": "..%2Fauth%2Fuser%3Fx=1%23top",
+		"..":                   "%2E%2E",
+		".":                    "%2E",
+		"a..b":                 "a..b",
+		int64(3):               "3",
+	}
+	for v, want := range tests {
+		if got := pathParam(v); got != want {
+			t.Errorf("pathParam(%v) = %q, want %q", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package vrchat
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+var (
+	// ErrChecksumMismatch is returned when downloaded data does not match the MD5 of the file version.
+	ErrChecksumMismatch = errors.New("MD5 checksum mismatch")
+	// ErrNoChecksum is returned when the MD5 of a file version is requested but not known.
+	ErrNoChecksum = errors.New("file version has no MD5 checksum")
+)
+
+// DownloadOptions configures DownloadFile.
+type DownloadOptions struct {
+	// Offset is the first byte to download, e.g. to resume a download.
+	Offset int64
+	// Length is the number of bytes to download, the rest of the file if zero.
+	Length int64
+	// VerifyMD5 verifies the data against FileData.Md5 of the version. The
+	// last Read returns ErrChecksumMismatch instead of io.EOF if they differ.
+	// It requires downloading the whole file.
+	VerifyMD5 bool
+}
+
+// DownloadFile streams the data of a file version. Redirects to the CDN are
+// followed, and the caller must close the returned body.
+//
+// The timeout set by WithTimeout includes reading the body, so it must allow
+// for the whole download. To download large files, create the client without
+// WithTimeout and bound each request with the deadline of ctx instead.
+func (c *Client) DownloadFile(ctx context.Context, fileId FileId, versionId int64, opts DownloadOptions) (io.ReadCloser, error) {
+	partial := opts.Offset > 0 || opts.Length > 0
+
+	var checksum string
+	if opts.VerifyMD5 {
+		if partial {
+			return nil, errors.New("MD5 can only be verified when downloading the whole file")
+		}
+		file, err := c.GetFileWithContext(ctx, GetFileParams{FileId: string(fileId)})
+		if err != nil {
+			return nil, fmt.Errorf("error getting file: %w", err)
+		}
+		for _, version := range file.Versions {
+			if version.Version == versionId {
+				checksum = version.File.Md5
+			}
+		}
+		if checksum == "" {
+			return nil, fmt.Errorf("%w: %s version %d", ErrNoChecksum, fileId, versionId)
+		}
+	}
+
+	headers := map[string]string{"Accept": "*/*"}
+	if partial {
+		rng := fmt.Sprintf("bytes=%d-", opts.Offset)
+		if opts.Length > 0 {
+			rng += fmt.Sprint(opts.Offset + opts.Length - 1)
+		}
+		headers["Range"] = rng
+	}
+
+	resp, err := c.downloadFileVersion(ctx, DownloadFileVersionParams{FileId: string(fileId), VersionId: versionId}, headers)
+	if err != nil {
+		return nil, err
+	}
+	body, err := streamResponse("downloadFileVersion", resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// A server that ignores the range sends the whole file
+	if partial && resp.StatusCode() == http.StatusOK {
+		if _, err := io.CopyN(io.Discard, body, opts.Offset); err != nil {
+			body.Close()
+			return nil, fmt.Errorf("error skipping to offset %d: %w", opts.Offset, err)
+		}
+		if opts.Length > 0 {
+			body = readCloser{io.LimitReader(body, opts.Length), body}
+		}
+	}
+
+	if checksum != "" {
+		body = &md5Reader{body: body, hash: md5.New(), want: checksum}
+	}
+	return body, nil
+}
+
+// streamResponse returns the body of a response requested with
+// SetDoNotParseResponse, or an *APIError for a non-2xx status code.
+func streamResponse(operationID string, resp *resty.Response) (io.ReadCloser, error) {
+	body := resp.RawBody()
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		data, _ := io.ReadAll(io.LimitReader(body, 1<<20))
+		body.Close()
+		resp.SetBody(data)
+		return nil, newAPIError(operationID, resp)
+	}
+	return body, nil
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// md5Reader computes the MD5 of the data read from body and compares it with want at EOF.
+type md5Reader struct {
+	body io.ReadCloser
+	hash hash.Hash
+	want string
+}
+
+func (r *md5Reader) Read(p []byte) (int, error) {
+	n, err := r.body.Read(p)
+	r.hash.Write(p[:n])
+	if err == io.EOF {
+		sum := r.hash.Sum(nil)
+		// The API reports the MD5 in base64, some older versions in hex
+		if got := base64.StdEncoding.EncodeToString(sum); got != r.want && !strings.EqualFold(fmt.Sprintf("%x", sum), r.want) {
+			return n, fmt.Errorf("%w: got %s, want %s", ErrChecksumMismatch, got, r.want)
+		}
+	}
+	return n, err
+}
+
+func (r *md5Reader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,150 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newDownloadServer returns a client for a server with version 1 of file_1,
+// whose data is content and reported MD5 is checksum. The data is served from
+// a CDN the API redirects to, which ignores Range headers unless ranges is set.
+// The Range header of the last CDN request is recorded.
+func newDownloadServer(t *testing.T, content []byte, checksum string, ranges bool) (*Client, *string) {
+	t.Helper()
+	var rangeHeader string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /file/file_1", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, File{Id: "file_1", Versions: []FileVersion{
+			{Version: 0},
+			{Version: 1, File: FileData{Md5: checksum, SizeInBytes: int64(len(content))}},
+		}})
+	})
+	mux.HandleFunc("GET /file/file_1/1", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/cdn/file_1", http.StatusFound)
+	})
+	mux.HandleFunc("GET /file/file_1/2", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "File version not found")
+	})
+	mux.HandleFunc("GET /cdn/file_1", func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader = r.Header.Get("Range")
+		if ranges {
+			http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(content))
+			return
+		}
+		w.Write(content)
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL), &rangeHeader
+}
+
+func TestDownloadFile(t *testing.T) {
+	content := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+	sum := md5.Sum(content)
+
+	tests := []struct {
+		name     string
+		checksum string
+		ranges   bool
+		opts     DownloadOptions
+		want     string
+		rng      string
+		wantErr  error
+	}{
+		{
+			name: "whole file",
+			want: string(content),
+		},
+		{
+			name:     "base64 MD5",
+			checksum: base64.StdEncoding.EncodeToString(sum[:]),
+			opts:     DownloadOptions{VerifyMD5: true},
+			want:     string(content),
+		},
+		{
+			name:     "hex MD5",
+			checksum: fmt.Sprintf("%X", sum),
+			opts:     DownloadOptions{VerifyMD5: true},
+			want:     string(content),
+		},
+		{
+			name:     "MD5 mismatch",
+			checksum: base64.StdEncoding.EncodeToString(make([]byte, md5.Size)),
+			opts:     DownloadOptions{VerifyMD5: true},
+			want:     string(content),
+			wantErr:  ErrChecksumMismatch,
+		},
+		{
+			name:   "range",
+			ranges: true,
+			opts:   DownloadOptions{Offset: 5, Length: 10},
+			want:   string(content[5:15]),
+			rng:    "bytes=5-14",
+		},
+		{
+			name:   "range to end",
+			ranges: true,
+			opts:   DownloadOptions{Offset: 30},
+			want:   string(content[30:]),
+			rng:    "bytes=30-",
+		},
+		{
+			name: "range ignored",
+			opts: DownloadOptions{Offset: 5, Length: 10},
+			want: string(content[5:15]),
+			rng:  "bytes=5-14",
+		},
+		{
+			name: "range to end ignored",
+			opts: DownloadOptions{Offset: 30},
+			want: string(content[30:]),
+			rng:  "bytes=30-",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, rng := newDownloadServer(t, content, tt.checksum, tt.ranges)
+			body, err := client.DownloadFile(context.Background(), "file_1", 1, tt.opts)
+			if err != nil {
+				t.Fatalf("DownloadFile() error = %v", err)
+			}
+			defer body.Close()
+
+			got, err := io.ReadAll(body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ReadAll() error = %v, want %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("data = %q, want %q", got, tt.want)
+			}
+			if *rng != tt.rng {
+				t.Errorf("Range = %q, want %q", *rng, tt.rng)
+			}
+		})
+	}
+}
+
+func TestDownloadFileErrors(t *testing.T) {
+	client, _ := newDownloadServer(t, []byte("data"), "", true)
+	ctx := context.Background()
+
+	if _, err := client.DownloadFile(ctx, "file_1", 1, DownloadOptions{VerifyMD5: true}); !errors.Is(err, ErrNoChecksum) {
+		t.Errorf("DownloadFile() without MD5 error = %v, want ErrNoChecksum", err)
+	}
+	if _, err := client.DownloadFile(ctx, "file_1", 1, DownloadOptions{Offset: 1, VerifyMD5: true}); err == nil {
+		t.Error("DownloadFile() of a range with VerifyMD5 succeeded, want error")
+	}
+	if _, err := client.DownloadFile(ctx, "file_1", 2, DownloadOptions{}); !IsNotFound(err) {
+		t.Errorf("DownloadFile() of missing version error = %v, want not found", err)
+	}
+}
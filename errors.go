@@ -0,0 +1,108 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// APIError is returned when the API responds with a non-2xx status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Message is the error message decoded from the response body, if any.
+	Message string
+	// OperationID is the ID of the operation in the API specification, e.g. "getGroup".
+	OperationID string
+	// Body is the raw response body.
+	Body []byte
+	// Detail is the typed error the specification defines for this operation
+	// and status code, e.g. *GroupNotFoundError, or nil if there is none.
+	Detail any
+}
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = string(e.Body)
+	}
+	return fmt.Sprintf("%s: unexpected status code: %d, message: %s", e.OperationID, e.StatusCode, msg)
+}
+
+func newAPIError(operationID string, resp *resty.Response) *APIError {
+	e := &APIError{
+		StatusCode:  resp.StatusCode(),
+		OperationID: operationID,
+		Body:        resp.Body(),
+	}
+
+	var body Error
+	if err := json.Unmarshal(e.Body, &body); err == nil {
+		e.Message = body.Error.Message
+	} else {
+		// Some endpoints respond with {"error": "message"} instead
+		var plain struct {
+			Error string `json:"error"`
+		}
+		if err := json.Unmarshal(e.Body, &plain); err == nil {
+			e.Message = plain.Error
+		}
+	}
+
+	if newDetail, ok := operationErrors[operationID][e.StatusCode]; ok {
+		detail := newDetail()
+		if err := json.Unmarshal(e.Body, detail); err == nil {
+			e.Detail = detail
+		}
+	}
+
+	return e
+}
+
+// ErrorDetail returns the typed error carried by an *APIError in err's chain,
+// e.g. ErrorDetail[GroupNotFoundError](err).
+func ErrorDetail[T any](err error) (*T, bool) {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return nil, false
+	}
+	detail, ok := apiErr.Detail.(*T)
+	return detail, ok
+}
+
+// StatusCode returns the status code of the *APIError in err's chain, or 0 if there is none.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return 0
+	}
+	return apiErr.StatusCode
+}
+
+// IsBadRequest reports whether err is an *APIError with status 400.
+func IsBadRequest(err error) bool {
+	return StatusCode(err) == http.StatusBadRequest
+}
+
+// IsUnauthorized reports whether err is an *APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is an *APIError with status 403.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// IsNotFound reports whether err is an *APIError with status 404.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsRateLimited reports whether err is an *APIError with status 429.
+func IsRateLimited(err error) bool {
+	return StatusCode(err) == http.StatusTooManyRequests
+}
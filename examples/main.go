@@ -1,18 +1,29 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/mayocream/vrchat-go"
 )
 
 func main() {
-	client := vrchat.NewClient("https://api.vrchat.cloud/api/1")
-
-	err := client.Authenticate("username", "password", "totp")
-	if err != nil {
-		panic(err)
-	}
+	client := vrchat.NewClient("https://api.vrchat.cloud/api/1",
+		vrchat.WithUserAgent("vrchat-go-example/0.0.0 example@example.com"),
+	)
 
-	user, err := client.GetCurrentUser()
+	ctx := context.Background()
+	user, err := client.LoginWithCodeProvider(ctx, "username", "password", vrchat.CodeProviderFunc(
+		func(ctx context.Context, req vrchat.CodeRequest) (vrchat.TwoFactorMethod, string, error) {
+			method := req.Methods[0]
+			fmt.Printf("enter %s code: ", method)
+			code, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			return method, strings.TrimSpace(code), err
+		},
+	))
 	if err != nil {
 		panic(err)
 	}
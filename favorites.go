@@ -0,0 +1,252 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+var (
+	// ErrFavoriteGroupFull is returned when a favorite group has reached its capacity.
+	ErrFavoriteGroupFull = errors.New("favorite group is full")
+	// ErrFavoriteGroupNotFound is returned when no favorite group of the type has the given name.
+	ErrFavoriteGroupNotFound = errors.New("favorite group not found")
+	// ErrFavoriteNotFound is returned when the item is not a favorite of the group.
+	ErrFavoriteNotFound = errors.New("favorite not found")
+)
+
+// FavoriteLimits holds the number of favorite groups and the capacity of each group per favorite type.
+type FavoriteLimits struct {
+	MaxFavoriteGroups    map[FavoriteType]int `json:"maxFavoriteGroups"`
+	MaxFavoritesPerGroup map[FavoriteType]int `json:"maxFavoritesPerGroup"`
+}
+
+// DefaultFavoriteLimits returns the limits of an account without VRChat+.
+func DefaultFavoriteLimits() FavoriteLimits {
+	return FavoriteLimits{
+		MaxFavoriteGroups: map[FavoriteType]int{
+			FavoriteTypeFriend: 3,
+			FavoriteTypeWorld:  4,
+			FavoriteTypeAvatar: 1,
+		},
+		MaxFavoritesPerGroup: map[FavoriteType]int{
+			FavoriteTypeFriend: 150,
+			FavoriteTypeWorld:  100,
+			FavoriteTypeAvatar: 50,
+		},
+	}
+}
+
+// merge returns the largest limits of l and o for each favorite type.
+func (l FavoriteLimits) merge(o FavoriteLimits) FavoriteLimits {
+	return l.combine(o, func(m, n int) int { return max(m, n) })
+}
+
+// override returns the limits of l overridden by the non-zero limits of o.
+func (l FavoriteLimits) override(o FavoriteLimits) FavoriteLimits {
+	return l.combine(o, func(_, n int) int { return n })
+}
+
+// combine returns the limits of l with each non-zero limit n of o replaced by
+// f of the limit of l and n.
+func (l FavoriteLimits) combine(o FavoriteLimits, f func(int, int) int) FavoriteLimits {
+	merged := FavoriteLimits{
+		MaxFavoriteGroups:    maps.Clone(l.MaxFavoriteGroups),
+		MaxFavoritesPerGroup: maps.Clone(l.MaxFavoritesPerGroup),
+	}
+	if merged.MaxFavoriteGroups == nil {
+		merged.MaxFavoriteGroups = make(map[FavoriteType]int)
+	}
+	if merged.MaxFavoritesPerGroup == nil {
+		merged.MaxFavoritesPerGroup = make(map[FavoriteType]int)
+	}
+	for t, n := range o.MaxFavoriteGroups {
+		if n > 0 {
+			merged.MaxFavoriteGroups[t] = f(merged.MaxFavoriteGroups[t], n)
+		}
+	}
+	for t, n := range o.MaxFavoritesPerGroup {
+		if n > 0 {
+			merged.MaxFavoritesPerGroup[t] = f(merged.MaxFavoritesPerGroup[t], n)
+		}
+	}
+	return merged
+}
+
+// FavoritesManager manages favorite groups and checks their capacity before adding favorites.
+type FavoritesManager struct {
+	client *Client
+
+	mu     sync.Mutex
+	limits *FavoriteLimits
+}
+
+// NewFavoritesManager creates a FavoritesManager using client.
+func NewFavoritesManager(client *Client) *FavoritesManager {
+	return &FavoritesManager{client: client}
+}
+
+// SetLimits overrides the favorite limits instead of loading them from the
+// permissions of the current user.
+func (m *FavoritesManager) SetLimits(limits FavoriteLimits) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	merged := DefaultFavoriteLimits().override(limits)
+	m.limits = &merged
+}
+
+// Limits returns the favorite limits. They are loaded once from the data of
+// the permissions assigned to the current user, such as the extra favorites of
+// VRChat+, and fall back to DefaultFavoriteLimits for the types no permission
+// covers. If several permissions set the same limit, the largest one applies.
+func (m *FavoritesManager) Limits(ctx context.Context) (FavoriteLimits, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.limits != nil {
+		return *m.limits, nil
+	}
+
+	permissions, err := m.client.GetAssignedPermissionsWithContext(ctx)
+	if err != nil {
+		return FavoriteLimits{}, fmt.Errorf("error getting permissions: %w", err)
+	}
+	limits := DefaultFavoriteLimits()
+	for _, permission := range *permissions {
+		limits = limits.merge(permissionFavoriteLimits(permission))
+	}
+	m.limits = &limits
+	return limits, nil
+}
+
+// permissionFavoriteLimits returns the maxFavoriteGroups and
+// maxFavoritesPerGroup of the permission data, or no limits if it has none.
+func permissionFavoriteLimits(permission Permission) FavoriteLimits {
+	var limits FavoriteLimits
+	data, err := json.Marshal(permission.Data)
+	if err != nil {
+		return FavoriteLimits{}
+	}
+	if err := json.Unmarshal(data, &limits); err != nil {
+		return FavoriteLimits{}
+	}
+	return limits
+}
+
+// Groups returns the favorite groups of the current user with the given type.
+func (m *FavoritesManager) Groups(ctx context.Context, favoriteType FavoriteType) ([]FavoriteGroup, error) {
+	var groups []FavoriteGroup
+	for group, err := range m.client.GetFavoriteGroupsIter(ctx, GetFavoriteGroupsParams{}, PageOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		if group.Type == favoriteType {
+			groups = append(groups, group)
+		}
+	}
+	return groups, nil
+}
+
+// Group returns the favorite group with the given type and name.
+func (m *FavoritesManager) Group(ctx context.Context, favoriteType FavoriteType, name string) (*FavoriteGroup, error) {
+	groups, err := m.Groups(ctx, favoriteType)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group.Name == name {
+			return &group, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s %s", ErrFavoriteGroupNotFound, favoriteType, name)
+}
+
+// Favorites returns the favorites in the group with the given name.
+func (m *FavoritesManager) Favorites(ctx context.Context, groupName string) ([]Favorite, error) {
+	var favorites []Favorite
+	for favorite, err := range m.client.GetFavoritesIter(ctx, GetFavoritesParams{Tag: Ptr(groupName)}, PageOptions{}) {
+		if err != nil {
+			return nil, err
+		}
+		favorites = append(favorites, favorite)
+	}
+	return favorites, nil
+}
+
+// checkCapacity returns ErrFavoriteGroupFull if the group cannot hold another favorite.
+func (m *FavoritesManager) checkCapacity(ctx context.Context, favoriteType FavoriteType, groupName string) error {
+	if _, err := m.Group(ctx, favoriteType, groupName); err != nil {
+		return err
+	}
+	limits, err := m.Limits(ctx)
+	if err != nil {
+		return err
+	}
+	favorites, err := m.Favorites(ctx, groupName)
+	if err != nil {
+		return err
+	}
+	if limit, ok := limits.MaxFavoritesPerGroup[favoriteType]; ok && len(favorites) >= limit {
+		return fmt.Errorf("%w: %s has %d of %d favorites", ErrFavoriteGroupFull, groupName, len(favorites), limit)
+	}
+	return nil
+}
+
+// Add adds favoriteId to the group with the given type and name.
+func (m *FavoritesManager) Add(ctx context.Context, favoriteType FavoriteType, favoriteId string, groupName string) (*FavoriteResponse, error) {
+	if err := m.checkCapacity(ctx, favoriteType, groupName); err != nil {
+		return nil, err
+	}
+	return m.client.AddFavoriteWithContext(ctx, AddFavoriteRequest{
+		FavoriteId: favoriteId,
+		Tags:       []Tag{Tag(groupName)},
+		Type:       favoriteType,
+	})
+}
+
+// Move moves favoriteId from the group named from to the group named to.
+// The favorite keeps the tags of any other groups it belongs to.
+func (m *FavoritesManager) Move(ctx context.Context, favoriteType FavoriteType, favoriteId string, from, to string) (*FavoriteResponse, error) {
+	favorites, err := m.Favorites(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	index := slices.IndexFunc(favorites, func(f Favorite) bool { return f.FavoriteId == favoriteId })
+	if index < 0 {
+		return nil, fmt.Errorf("%w: %s in %s", ErrFavoriteNotFound, favoriteId, from)
+	}
+	favorite := favorites[index]
+
+	if err := m.checkCapacity(ctx, favoriteType, to); err != nil {
+		return nil, err
+	}
+
+	tags := slices.DeleteFunc(slices.Clone(favorite.Tags), func(t Tag) bool { return t == Tag(from) || t == Tag(to) })
+	tags = append(tags, Tag(to))
+
+	// Removing a favorite removes it from every group, so it is added again with the new tags
+	if _, err := m.client.RemoveFavoriteWithContext(ctx, RemoveFavoriteParams{FavoriteId: string(favorite.Id)}); err != nil {
+		return nil, err
+	}
+	result, err := m.client.AddFavoriteWithContext(ctx, AddFavoriteRequest{FavoriteId: favoriteId, Tags: tags, Type: favoriteType})
+	if err != nil {
+		// Restore the favorite in its previous groups
+		if _, restoreErr := m.client.AddFavoriteWithContext(ctx, AddFavoriteRequest{FavoriteId: favoriteId, Tags: favorite.Tags, Type: favoriteType}); restoreErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("error restoring favorite: %w", restoreErr))
+		}
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateGroup updates the display name, visibility or tags of the favorite group.
+func (m *FavoritesManager) UpdateGroup(ctx context.Context, group FavoriteGroup, body UpdateFavoriteGroupRequest) error {
+	return m.client.UpdateFavoriteGroupWithContext(ctx, UpdateFavoriteGroupParams{
+		FavoriteGroupType: string(group.Type),
+		FavoriteGroupName: group.Name,
+		UserId:            string(group.OwnerId),
+	}, body)
+}
@@ -0,0 +1,176 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFavoritesManagerLimits(t *testing.T) {
+	client, requests := newRecordingServer(t, `[
+		{"id": "pms_1", "name": "permission-extra-favorites-avatar-groups", "data": {"maxFavoriteGroups": {"avatar": 6}, "maxFavoritesPerGroup": {"avatar": 50}}},
+		{"id": "pms_2", "name": "permission-extra-favorites-world-groups", "data": {"maxFavoriteGroups": {"world": 8}}},
+		{"id": "pms_5", "name": "permission-extra-favorites-avatar-groups-small", "data": {"maxFavoriteGroups": {"avatar": 2}}},
+		{"id": "pms_3", "name": "permission-user-gifts", "data": "gifts"},
+		{"id": "pms_4", "name": "permission-persistence"}
+	]`)
+	favorites := NewFavoritesManager(client)
+
+	limits, err := favorites.Limits(context.Background())
+	if err != nil {
+		t.Fatalf("Limits() error = %v", err)
+	}
+	want := DefaultFavoriteLimits()
+	want.MaxFavoriteGroups[FavoriteTypeAvatar] = 6
+	want.MaxFavoriteGroups[FavoriteTypeWorld] = 8
+	for typ, n := range want.MaxFavoriteGroups {
+		if limits.MaxFavoriteGroups[typ] != n {
+			t.Errorf("MaxFavoriteGroups[%s] = %d, want %d", typ, limits.MaxFavoriteGroups[typ], n)
+		}
+	}
+	for typ, n := range want.MaxFavoritesPerGroup {
+		if limits.MaxFavoritesPerGroup[typ] != n {
+			t.Errorf("MaxFavoritesPerGroup[%s] = %d, want %d", typ, limits.MaxFavoritesPerGroup[typ], n)
+		}
+	}
+
+	if _, err := favorites.Limits(context.Background()); err != nil {
+		t.Fatalf("Limits() error = %v", err)
+	}
+	if len(*requests) != 1 || (*requests)[0].Path != "/auth/permissions" {
+		t.Errorf("requests = %+v, want one GET /auth/permissions", *requests)
+	}
+}
+
+// newFavoritesServer returns a FavoritesManager for a server with the world
+// favorite groups worlds1 and worlds2, which hold two favorites per group.
+// The server fails the next failAdds requests to add a favorite and records
+// the requests that change favorites.
+func newFavoritesServer(t *testing.T, favorites []Favorite, failAdds int) (*FavoritesManager, func() []string) {
+	t.Helper()
+	var changes []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/favorite/groups":
+			writeJSON(w, []FavoriteGroup{
+				{Name: "worlds1", Type: FavoriteTypeWorld},
+				{Name: "worlds2", Type: FavoriteTypeWorld},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/favorites":
+			page := []Favorite{}
+			if r.URL.Query().Get("offset") == "0" {
+				for _, favorite := range favorites {
+					if slices.Contains(favorite.Tags, Tag(r.URL.Query().Get("tag"))) {
+						page = append(page, favorite)
+					}
+				}
+			}
+			writeJSON(w, page)
+		case r.Method == http.MethodPost && r.URL.Path == "/favorites":
+			var body AddFavoriteRequest
+			json.NewDecoder(r.Body).Decode(&body)
+			changes = append(changes, fmt.Sprintf("add %s %v", body.FavoriteId, body.Tags))
+			if failAdds > 0 {
+				failAdds--
+				writeError(w, http.StatusBadRequest, "You already have that favorite")
+				return
+			}
+			writeJSON(w, Favorite{Id: "fvrt_new", FavoriteId: body.FavoriteId, Tags: body.Tags, Type: body.Type})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/favorites/"):
+			changes = append(changes, "remove "+strings.TrimPrefix(r.URL.Path, "/favorites/"))
+			writeJSON(w, map[string]any{})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	manager := NewFavoritesManager(NewClient(srv.URL))
+	manager.SetLimits(FavoriteLimits{MaxFavoritesPerGroup: map[FavoriteType]int{FavoriteTypeWorld: 2}})
+	return manager, func() []string { return changes }
+}
+
+// fullGroupFavorites has worlds1 with one favorite and worlds2 with two.
+var fullGroupFavorites = []Favorite{
+	{Id: "fvrt_1", FavoriteId: "wrld_1", Tags: []Tag{"worlds1"}, Type: FavoriteTypeWorld},
+	{Id: "fvrt_2", FavoriteId: "wrld_2", Tags: []Tag{"worlds2"}, Type: FavoriteTypeWorld},
+	{Id: "fvrt_3", FavoriteId: "wrld_3", Tags: []Tag{"worlds2"}, Type: FavoriteTypeWorld},
+}
+
+func TestFavoritesManagerFullGroup(t *testing.T) {
+	ctx := context.Background()
+	favorites, changes := newFavoritesServer(t, fullGroupFavorites, 0)
+
+	if _, err := favorites.Add(ctx, FavoriteTypeWorld, "wrld_4", "worlds2"); !errors.Is(err, ErrFavoriteGroupFull) {
+		t.Errorf("Add() error = %v, want ErrFavoriteGroupFull", err)
+	}
+	if _, err := favorites.Move(ctx, FavoriteTypeWorld, "wrld_1", "worlds1", "worlds2"); !errors.Is(err, ErrFavoriteGroupFull) {
+		t.Errorf("Move() error = %v, want ErrFavoriteGroupFull", err)
+	}
+	if _, err := favorites.Add(ctx, FavoriteTypeWorld, "wrld_4", "worlds3"); !errors.Is(err, ErrFavoriteGroupNotFound) {
+		t.Errorf("Add() error = %v, want ErrFavoriteGroupNotFound", err)
+	}
+	if got := changes(); len(got) != 0 {
+		t.Errorf("changes = %q, want none", got)
+	}
+
+	if _, err := favorites.Add(ctx, FavoriteTypeWorld, "wrld_4", "worlds1"); err != nil {
+		t.Errorf("Add() error = %v", err)
+	}
+	if want := []string{"add wrld_4 [worlds1]"}; !reflect.DeepEqual(changes(), want) {
+		t.Errorf("changes = %q, want %q", changes(), want)
+	}
+}
+
+func TestFavoritesManagerMove(t *testing.T) {
+	tests := []struct {
+		name       string
+		failAdds   int
+		wantErr    bool
+		wantChange []string
+	}{
+		{
+			name:       "moved",
+			wantChange: []string{"remove fvrt_2", "add wrld_2 [worlds1]"},
+		},
+		{
+			name:       "restored",
+			failAdds:   1,
+			wantErr:    true,
+			wantChange: []string{"remove fvrt_2", "add wrld_2 [worlds1]", "add wrld_2 [worlds2]"},
+		},
+		{
+			name:       "restore failed",
+			failAdds:   2,
+			wantErr:    true,
+			wantChange: []string{"remove fvrt_2", "add wrld_2 [worlds1]", "add wrld_2 [worlds2]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			favorites, changes := newFavoritesServer(t, fullGroupFavorites, tt.failAdds)
+			_, err := favorites.Move(context.Background(), FavoriteTypeWorld, "wrld_2", "worlds2", "worlds1")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Move() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && StatusCode(err) != http.StatusBadRequest {
+				t.Errorf("StatusCode(%v) = %d, want 400", err, StatusCode(err))
+			}
+			if restoreFailed := err != nil && strings.Contains(err.Error(), "error restoring favorite"); restoreFailed != (tt.failAdds > 1) {
+				t.Errorf("Move() error = %v, want restore error %v", err, tt.failAdds > 1)
+			}
+			if !reflect.DeepEqual(changes(), tt.wantChange) {
+				t.Errorf("changes = %q, want %q", changes(), tt.wantChange)
+			}
+		})
+	}
+}
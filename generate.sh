@@ -1,7 +1,17 @@
 #!/bin/bash
+#
+# Generates the client from the latest specification into $OUT (a temporary
+# directory by default). The committed *.gen.go files are maintained by hand
+# on top of the generator output, so they are not overwritten: compare the
+# output with them to pick up changes to the specification.
+set -euo pipefail
 
-wget https://vrchatapi.github.io/specification/openapi.yaml -O openapi.yaml
+OUT=${OUT:-$(mktemp -d)}
+
+wget https://vrchatapi.github.io/specification/openapi.yaml -O "$OUT/openapi.yaml"
 
 go install github.com/mayocream/openapi-codegen@latest
 
-openapi-codegen -i ./openapi.yaml -o . -p vrchat
+openapi-codegen -i "$OUT/openapi.yaml" -o "$OUT" -p vrchat
+
+echo "Generated into $OUT, compare with: diff -u client.gen.go $OUT/client.gen.go"
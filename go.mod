@@ -4,10 +4,10 @@ go 1.23.1
 
 require (
 	github.com/go-resty/resty/v2 v2.15.0
-	github.com/samber/lo v1.47.0
+	golang.org/x/crypto v0.31.0
 )
 
 require (
 	golang.org/x/net v0.27.0 // indirect
-	golang.org/x/text v0.16.0 // indirect
+	golang.org/x/sys v0.28.0 // indirect
 )
@@ -0,0 +1,233 @@
+package vrchat
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  string
+	Body   any
+}
+
+// newRecordingServer returns a client for a server that records the decoded
+// JSON body of every request and responds with response.
+func newRecordingServer(t *testing.T, response string) (*Client, *[]recordedRequest) {
+	t.Helper()
+	var requests []recordedRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("error reading body: %v", err)
+		}
+		rec := recordedRequest{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery}
+		if len(data) > 0 {
+			if err := json.Unmarshal(data, &rec.Body); err != nil {
+				t.Errorf("%s %s: body %q is not JSON: %v", r.Method, r.URL.Path, data, err)
+			}
+		}
+		requests = append(requests, rec)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(response))
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL), &requests
+}
+
+func decodeJSON(t *testing.T, s string) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatalf("invalid JSON %q: %v", s, err)
+	}
+	return v
+}
+
+// requestTest is a call whose request is checked by runRequestTests.
+type requestTest struct {
+	name   string
+	call   func(c *Client) error
+	method string
+	path   string
+	query  string
+	// body is the expected JSON body, or empty if no body is sent.
+	body string
+	// response is the JSON response of the server, {} if empty.
+	response string
+}
+
+// runRequestTests runs each call against a recording server and checks that
+// it sends exactly one request with the expected method, path, query and body.
+func runRequestTests(t *testing.T, tests []requestTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := tt.response
+			if response == "" {
+				response = `{}`
+			}
+			c, requests := newRecordingServer(t, response)
+			if err := tt.call(c); err != nil {
+				t.Fatalf("%s: %v", tt.name, err)
+			}
+			if len(*requests) != 1 {
+				t.Fatalf("server received %d requests, want 1", len(*requests))
+			}
+			got := (*requests)[0]
+			if got.Method != tt.method || got.Path != tt.path || got.Query != tt.query {
+				t.Errorf("request = %s %s?%s, want %s %s?%s", got.Method, got.Path, got.Query, tt.method, tt.path, tt.query)
+			}
+			var want any
+			if tt.body != "" {
+				want = decodeJSON(t, tt.body)
+			}
+			if !reflect.DeepEqual(got.Body, want) {
+				t.Errorf("body = %v, want %v", got.Body, want)
+			}
+		})
+	}
+}
+
+func TestGroupRequestBodies(t *testing.T) {
+	runRequestTests(t, []requestTest{
+		{
+			name: "CreateGroup",
+			call: func(c *Client) error {
+				_, err := c.CreateGroup(CreateGroupRequest{
+					Name:         "Test Group",
+					ShortCode:    "TEST",
+					RoleTemplate: GroupRoleTemplateDefault,
+					Privacy:      Ptr(GroupPrivacyPrivate),
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups",
+			body:   `{"name":"Test Group","shortCode":"TEST","roleTemplate":"default","privacy":"private"}`,
+		},
+		{
+			name: "UpdateGroup",
+			call: func(c *Client) error {
+				_, err := c.UpdateGroup(UpdateGroupParams{GroupId: "grp_1"}, UpdateGroupRequest{
+					Description: Ptr(""),
+					Links:       Ptr([]string{}),
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/groups/grp_1",
+			body:   `{"description":"","links":[]}`,
+		},
+		{
+			name: "CreateGroupRole",
+			call: func(c *Client) error {
+				_, err := c.CreateGroupRole(CreateGroupRoleParams{GroupId: "grp_1"}, CreateGroupRoleRequest{
+					Name:        Ptr("Moderator"),
+					Permissions: Ptr([]string{"group-bans-manage"}),
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups/grp_1/roles",
+			body:   `{"name":"Moderator","permissions":["group-bans-manage"]}`,
+		},
+		{
+			name: "UpdateGroupRole",
+			call: func(c *Client) error {
+				_, err := c.UpdateGroupRole(UpdateGroupRoleParams{GroupId: "grp_1", GroupRoleId: "grol_1"}, UpdateGroupRoleRequest{
+					IsSelfAssignable: Ptr(false),
+					Order:            Ptr(int64(0)),
+				})
+				return err
+			},
+			method:   http.MethodPut,
+			path:     "/groups/grp_1/roles/grol_1",
+			body:     `{"isSelfAssignable":false,"order":0}`,
+			response: `[]`,
+		},
+		{
+			name: "AddGroupPost",
+			call: func(c *Client) error {
+				_, err := c.AddGroupPost(AddGroupPostParams{GroupId: "grp_1"}, CreateGroupPostRequest{
+					Title:      "Hello",
+					Text:       "World",
+					Visibility: GroupPostVisibilityGroup,
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups/grp_1/posts",
+			body:   `{"title":"Hello","text":"World","sendNotification":false,"visibility":"group"}`,
+		},
+		{
+			name: "UpdateGroupPost",
+			call: func(c *Client) error {
+				_, err := c.UpdateGroupPost(UpdateGroupPostParams{GroupId: "grp_1", NotificationId: "not_1"}, CreateGroupPostRequest{
+					Title:            "Hello",
+					Text:             "Edited",
+					SendNotification: true,
+					Visibility:       GroupPostVisibilityPublic,
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/groups/grp_1/posts/not_1",
+			body:   `{"title":"Hello","text":"Edited","sendNotification":true,"visibility":"public"}`,
+		},
+		{
+			name: "CreateGroupGallery",
+			call: func(c *Client) error {
+				_, err := c.CreateGroupGallery(CreateGroupGalleryParams{GroupId: "grp_1"}, CreateGroupGalleryRequest{
+					Name:        "Photos",
+					MembersOnly: Ptr(true),
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups/grp_1/galleries",
+			body:   `{"name":"Photos","membersOnly":true}`,
+		},
+		{
+			name: "UpdateGroupGallery",
+			call: func(c *Client) error {
+				_, err := c.UpdateGroupGallery(UpdateGroupGalleryParams{GroupId: "grp_1", GroupGalleryId: "ggal_1"}, UpdateGroupGalleryRequest{
+					MembersOnly:   Ptr(false),
+					RoleIdsToView: Ptr([]GroupRoleId{"grol_1"}),
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/groups/grp_1/galleries/ggal_1",
+			body:   `{"membersOnly":false,"roleIdsToView":["grol_1"]}`,
+		},
+		{
+			name: "CreateGroupAnnouncement",
+			call: func(c *Client) error {
+				_, err := c.CreateGroupAnnouncement(CreateGroupAnnouncementParams{GroupId: "grp_1"}, CreateGroupAnnouncementRequest{
+					Title:            "News",
+					SendNotification: Ptr(true),
+				})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups/grp_1/announcement",
+			body:   `{"title":"News","sendNotification":true}`,
+		},
+		{
+			name: "BanGroupMember",
+			call: func(c *Client) error {
+				_, err := c.BanGroupMember(BanGroupMemberParams{GroupId: "grp_1"}, BanGroupMemberRequest{UserId: "usr_1"})
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/groups/grp_1/bans",
+			body:   `{"userId":"usr_1"}`,
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package vrchat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidInstanceRequest is returned for CreateInstanceRequest values the
+// API would reject.
+var ErrInvalidInstanceRequest = errors.New("invalid instance request")
+
+// PublicInstance returns a request for a public instance of world.
+func PublicInstance(world WorldId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return newInstanceRequest(CreateInstanceRequest{
+		WorldId: world,
+		Type:    InstanceTypePublic,
+		Region:  region,
+	})
+}
+
+// FriendsPlusInstance returns a request for a Friends+ instance of world owned by owner.
+func FriendsPlusInstance(world WorldId, owner UserId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return newInstanceRequest(CreateInstanceRequest{
+		WorldId: world,
+		Type:    InstanceTypeHidden,
+		Region:  region,
+		OwnerId: Ptr(InstanceOwnerId(owner)),
+	})
+}
+
+// FriendsInstance returns a request for a Friends instance of world owned by owner.
+func FriendsInstance(world WorldId, owner UserId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return newInstanceRequest(CreateInstanceRequest{
+		WorldId: world,
+		Type:    InstanceTypeFriends,
+		Region:  region,
+		OwnerId: Ptr(InstanceOwnerId(owner)),
+	})
+}
+
+// InvitePlusInstance returns a request for an Invite+ instance of world owned by owner.
+func InvitePlusInstance(world WorldId, owner UserId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return newInstanceRequest(CreateInstanceRequest{
+		WorldId:          world,
+		Type:             InstanceTypePrivate,
+		Region:           region,
+		OwnerId:          Ptr(InstanceOwnerId(owner)),
+		CanRequestInvite: Ptr(true),
+	})
+}
+
+// InviteInstance returns a request for an Invite instance of world owned by owner.
+func InviteInstance(world WorldId, owner UserId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return newInstanceRequest(CreateInstanceRequest{
+		WorldId: world,
+		Type:    InstanceTypePrivate,
+		Region:  region,
+		OwnerId: Ptr(InstanceOwnerId(owner)),
+	})
+}
+
+// GroupPublicInstance returns a request for a Group Public instance of world owned by group.
+func GroupPublicInstance(world WorldId, group GroupId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return groupInstance(world, group, region, GroupAccessTypePublic, nil)
+}
+
+// GroupPlusInstance returns a request for a Group+ instance of world owned by group.
+func GroupPlusInstance(world WorldId, group GroupId, region InstanceRegion) (CreateInstanceRequest, error) {
+	return groupInstance(world, group, region, GroupAccessTypePlus, nil)
+}
+
+// GroupMembersInstance returns a request for a Group instance of world owned by group.
+// If roles are given, only members with one of them may join.
+func GroupMembersInstance(world WorldId, group GroupId, region InstanceRegion, roles ...GroupRoleId) (CreateInstanceRequest, error) {
+	return groupInstance(world, group, region, GroupAccessTypeMembers, roles)
+}
+
+func groupInstance(world WorldId, group GroupId, region InstanceRegion, access GroupAccessType, roles []GroupRoleId) (CreateInstanceRequest, error) {
+	r := CreateInstanceRequest{
+		WorldId:         world,
+		Type:            InstanceTypeGroup,
+		Region:          region,
+		OwnerId:         Ptr(InstanceOwnerId(group)),
+		GroupAccessType: Ptr(access),
+	}
+	if len(roles) > 0 {
+		r.RoleIds = &roles
+	}
+	return newInstanceRequest(r)
+}
+
+func newInstanceRequest(r CreateInstanceRequest) (CreateInstanceRequest, error) {
+	if err := r.Validate(); err != nil {
+		return CreateInstanceRequest{}, err
+	}
+	return r, nil
+}
+
+// Validate checks that the combination of fields in r is accepted by the API.
+func (r CreateInstanceRequest) Validate() error {
+	invalid := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s", ErrInvalidInstanceRequest, fmt.Sprintf(format, args...))
+	}
+
+	if !strings.HasPrefix(string(r.WorldId), "wrld_") {
+		return invalid("world ID %q must start with wrld_", r.WorldId)
+	}
+	switch r.Region {
+	case InstanceRegionUs, InstanceRegionUse, InstanceRegionEu, InstanceRegionJp:
+	default:
+		return invalid("unknown region %q", r.Region)
+	}
+
+	var owner string
+	if r.OwnerId != nil {
+		owner = string(*r.OwnerId)
+	}
+	isGroup := r.Type == InstanceTypeGroup
+	if !isGroup && (r.GroupAccessType != nil || r.RoleIds != nil) {
+		return invalid("group access type and roles require a group instance")
+	}
+	if r.CanRequestInvite != nil && *r.CanRequestInvite && r.Type != InstanceTypePrivate {
+		return invalid("requesting invites requires an invite instance")
+	}
+
+	switch r.Type {
+	case InstanceTypePublic:
+		if owner != "" {
+			return invalid("public instances have no owner")
+		}
+		if r.ClosedAt != nil {
+			return invalid("public instances cannot be closed")
+		}
+	case InstanceTypeHidden, InstanceTypeFriends, InstanceTypePrivate:
+		if owner == "" || strings.HasPrefix(owner, "grp_") {
+			return invalid("%s instances must be owned by a user", r.Type)
+		}
+	case InstanceTypeGroup:
+		if !strings.HasPrefix(owner, "grp_") {
+			return invalid("group instances must be owned by a group, got %q", owner)
+		}
+		if r.GroupAccessType == nil {
+			return invalid("group instances require a group access type")
+		}
+		switch *r.GroupAccessType {
+		case GroupAccessTypePublic, GroupAccessTypePlus:
+			if r.RoleIds != nil && len(*r.RoleIds) > 0 {
+				return invalid("roles only apply to members group instances")
+			}
+		case GroupAccessTypeMembers:
+		default:
+			return invalid("unknown group access type %q", *r.GroupAccessType)
+		}
+	default:
+		return invalid("unknown instance type %q", r.Type)
+	}
+	return nil
+}
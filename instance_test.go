@@ -0,0 +1,61 @@
+package vrchat
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCreateInstanceRequestValidate(t *testing.T) {
+	user := Ptr(InstanceOwnerId("usr_xxx"))
+	group := Ptr(InstanceOwnerId("grp_xxx"))
+	members := Ptr(GroupAccessTypeMembers)
+
+	tests := []struct {
+		name  string
+		req   CreateInstanceRequest
+		valid bool
+	}{
+		{"public", CreateInstanceRequest{Type: InstanceTypePublic}, true},
+		{"public with owner", CreateInstanceRequest{Type: InstanceTypePublic, OwnerId: user}, false},
+		{"public closed", CreateInstanceRequest{Type: InstanceTypePublic, ClosedAt: Ptr(time.Now())}, false},
+		{"friends+", CreateInstanceRequest{Type: InstanceTypeHidden, OwnerId: user}, true},
+		{"friends without owner", CreateInstanceRequest{Type: InstanceTypeFriends}, false},
+		{"invite owned by group", CreateInstanceRequest{Type: InstanceTypePrivate, OwnerId: group}, false},
+		{"invite+", CreateInstanceRequest{Type: InstanceTypePrivate, OwnerId: user, CanRequestInvite: Ptr(true)}, true},
+		{"friends requesting invites", CreateInstanceRequest{Type: InstanceTypeFriends, OwnerId: user, CanRequestInvite: Ptr(true)}, false},
+		{"friends with group access", CreateInstanceRequest{Type: InstanceTypeFriends, OwnerId: user, GroupAccessType: members}, false},
+		{"group members with roles", CreateInstanceRequest{Type: InstanceTypeGroup, OwnerId: group, GroupAccessType: members, RoleIds: &[]GroupRoleId{"grol_xxx"}}, true},
+		{"group owned by user", CreateInstanceRequest{Type: InstanceTypeGroup, OwnerId: user, GroupAccessType: members}, false},
+		{"group without access type", CreateInstanceRequest{Type: InstanceTypeGroup, OwnerId: group}, false},
+		{"group public with roles", CreateInstanceRequest{Type: InstanceTypeGroup, OwnerId: group, GroupAccessType: Ptr(GroupAccessTypePublic), RoleIds: &[]GroupRoleId{"grol_xxx"}}, false},
+		{"group unknown access type", CreateInstanceRequest{Type: InstanceTypeGroup, OwnerId: group, GroupAccessType: Ptr(GroupAccessType("everyone"))}, false},
+		{"unknown type", CreateInstanceRequest{Type: InstanceType("secret"), OwnerId: user}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.WorldId = "wrld_xxx"
+			tt.req.Region = InstanceRegionJp
+			err := tt.req.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("Validate() error = %v, want nil", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidInstanceRequest) {
+				t.Errorf("Validate() error = %v, want ErrInvalidInstanceRequest", err)
+			}
+		})
+	}
+}
+
+func TestCreateInstanceRequestValidateWorldAndRegion(t *testing.T) {
+	if _, err := PublicInstance("usr_xxx", InstanceRegionJp); !errors.Is(err, ErrInvalidInstanceRequest) {
+		t.Errorf("PublicInstance with user ID error = %v, want ErrInvalidInstanceRequest", err)
+	}
+	if _, err := PublicInstance("wrld_xxx", InstanceRegion("mars")); !errors.Is(err, ErrInvalidInstanceRequest) {
+		t.Errorf("PublicInstance with unknown region error = %v, want ErrInvalidInstanceRequest", err)
+	}
+	if _, err := GroupMembersInstance("wrld_xxx", "grp_xxx", InstanceRegionEu, "grol_xxx"); err != nil {
+		t.Errorf("GroupMembersInstance error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,49 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInviteMustBeFriends is returned by InviteToLocation and RequestInviteFrom
+// when the other user is not a friend. The error also carries the
+// *InviteMustBeFriendsError detail, see ErrorDetail.
+var ErrInviteMustBeFriends = errors.New("invites require friendship")
+
+// InviteToLocation invites the user with userId to location. If messageSlot is
+// not nil, the invite message in that slot is attached.
+func (c *Client) InviteToLocation(ctx context.Context, userId UserId, location Location, messageSlot *int64) (*SendNotificationResponse, error) {
+	notification, err := c.InviteUserWithContext(ctx, InviteUserParams{UserId: string(userId)}, InviteRequest{
+		InstanceId:  InstanceId(location.String()),
+		MessageSlot: messageSlot,
+	})
+	if err != nil {
+		return nil, inviteError(err)
+	}
+	return notification, nil
+}
+
+// RequestInviteFrom asks the user with userId for an invite. If messageSlot is
+// not nil, the request message in that slot is attached.
+func (c *Client) RequestInviteFrom(ctx context.Context, userId UserId, messageSlot *int64) (*NotificationResponse, error) {
+	notification, err := c.RequestInviteWithContext(ctx, RequestInviteParams{UserId: string(userId)}, RequestInviteRequest{
+		MessageSlot: messageSlot,
+	})
+	if err != nil {
+		return nil, inviteError(err)
+	}
+	return notification, nil
+}
+
+// inviteError wraps err with ErrInviteMustBeFriends if the API rejected the
+// invite because the users are not friends. The API reports other 403 errors,
+// such as rate limits, with the same status code but a different message.
+func inviteError(err error) error {
+	detail, ok := ErrorDetail[InviteMustBeFriendsError](err)
+	if ok && strings.Contains(strings.ToLower(detail.Error.Message), "friends with") {
+		return fmt.Errorf("%w: %w", ErrInviteMustBeFriends, err)
+	}
+	return err
+}
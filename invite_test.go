@@ -0,0 +1,85 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInviteRequestBodies(t *testing.T) {
+	ctx := context.Background()
+	location, err := ParseLocation("wrld_1:12345~hidden(usr_2)~region(eu)")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	runRequestTests(t, []requestTest{
+		{
+			name: "InviteToLocation",
+			call: func(c *Client) error {
+				_, err := c.InviteToLocation(ctx, "usr_1", location, Ptr[int64](2))
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/invite/usr_1",
+			body:   `{"instanceId":"wrld_1:12345~hidden(usr_2)~region(eu)","messageSlot":2}`,
+		},
+		{
+			name: "InviteToLocation without message",
+			call: func(c *Client) error {
+				_, err := c.InviteToLocation(ctx, "usr_1", location, nil)
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/invite/usr_1",
+			body:   `{"instanceId":"wrld_1:12345~hidden(usr_2)~region(eu)"}`,
+		},
+		{
+			name: "RequestInviteFrom",
+			call: func(c *Client) error {
+				_, err := c.RequestInviteFrom(ctx, "usr_1", Ptr[int64](0))
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/requestInvite/usr_1",
+			body:   `{"messageSlot":0}`,
+		},
+	})
+}
+
+func TestInviteErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		message       string
+		mustBeFriends bool
+	}{
+		{"not friends", http.StatusForbidden, `"You need to be friends with that user first."`, true},
+		{"rate limited", http.StatusForbidden, `"You're sending too many invites, slow down."`, false},
+		{"not allowed", http.StatusForbidden, `"You are not allowed to invite to this instance."`, false},
+		{"not found", http.StatusNotFound, `"User not found"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				writeError(w, tt.status, tt.message)
+			}))
+			t.Cleanup(srv.Close)
+			client := NewClient(srv.URL)
+
+			_, inviteErr := client.InviteToLocation(context.Background(), "usr_1", Location{WorldId: "wrld_1", InstanceId: "12345"}, nil)
+			_, requestErr := client.RequestInviteFrom(context.Background(), "usr_1", nil)
+			for _, err := range []error{inviteErr, requestErr} {
+				if got := errors.Is(err, ErrInviteMustBeFriends); got != tt.mustBeFriends {
+					t.Errorf("errors.Is(%v, ErrInviteMustBeFriends) = %v, want %v", err, got, tt.mustBeFriends)
+				}
+				if StatusCode(err) != tt.status {
+					t.Errorf("StatusCode(%v) = %d, want %d", err, StatusCode(err), tt.status)
+				}
+			}
+		})
+	}
+}
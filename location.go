@@ -0,0 +1,143 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrNoLocation is returned by ParseLocation for locations that do not refer
+// to an instance, such as "offline", "private" and "traveling".
+var ErrNoLocation = errors.New("location does not refer to an instance")
+
+// Location is a parsed instance location of the form
+// "wrld_xxx:12345~hidden(usr_xxx)~region(eu)".
+type Location struct {
+	WorldId WorldId
+	// InstanceId is the part after the colon, e.g. "12345~hidden(usr_xxx)~region(eu)".
+	InstanceId string
+	// Name is the instance name, e.g. "12345".
+	Name string
+	Type InstanceType
+	// OwnerId is the user or group that owns a non-public instance.
+	OwnerId          string
+	Region           InstanceRegion
+	CanRequestInvite bool
+	GroupAccessType  GroupAccessType
+	Nonce            string
+}
+
+// ParseLocation parses an instance location such as the Location field of an Instance.
+func ParseLocation(s string) (Location, error) {
+	switch s {
+	case "", "offline", "private", "traveling":
+		return Location{}, fmt.Errorf("%w: %q", ErrNoLocation, s)
+	}
+	world, instance, ok := strings.Cut(s, ":")
+	if !ok || !strings.HasPrefix(world, "wrld_") || instance == "" {
+		return Location{}, fmt.Errorf("invalid location: %q", s)
+	}
+
+	l := Location{
+		WorldId:    WorldId(world),
+		InstanceId: instance,
+		Type:       InstanceTypePublic,
+		Region:     InstanceRegionUs,
+	}
+	parts := strings.Split(instance, "~")
+	l.Name = parts[0]
+	for _, part := range parts[1:] {
+		key, value, _ := strings.Cut(part, "(")
+		value = strings.TrimSuffix(value, ")")
+		switch key {
+		case "hidden":
+			l.Type, l.OwnerId = InstanceTypeHidden, value
+		case "friends":
+			l.Type, l.OwnerId = InstanceTypeFriends, value
+		case "private":
+			l.Type, l.OwnerId = InstanceTypePrivate, value
+		case "group":
+			l.Type, l.OwnerId = InstanceTypeGroup, value
+		case "groupAccessType":
+			l.GroupAccessType = GroupAccessType(value)
+		case "canRequestInvite":
+			l.CanRequestInvite = true
+		case "region":
+			l.Region = InstanceRegion(value)
+		case "nonce":
+			l.Nonce = value
+		}
+	}
+	return l, nil
+}
+
+// String returns the location in the form accepted by the API.
+func (l Location) String() string {
+	return string(l.WorldId) + ":" + l.InstanceId
+}
+
+// ResolveInstanceURL returns the instance an invite link refers to. It accepts
+// short links such as "https://vrch.at/abcd1234", launch links such as
+// "vrchat://launch?id=wrld_xxx:12345~region(eu)" and
+// "https://vrchat.com/home/launch?worldId=wrld_xxx&instanceId=12345", and
+// bare locations.
+func (c *Client) ResolveInstanceURL(ctx context.Context, rawURL string) (*Instance, error) {
+	shortName, location, err := parseInstanceURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var instance *InstanceResponse
+	if shortName != "" {
+		instance, err = c.GetInstanceByShortNameWithContext(ctx, GetInstanceByShortNameParams{ShortName: shortName})
+	} else {
+		instance, err = c.GetInstanceWithContext(ctx, GetInstanceParams{
+			WorldId:    string(location.WorldId),
+			InstanceId: location.InstanceId,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return (*Instance)(instance), nil
+}
+
+// parseInstanceURL returns either the short name or the location of an instance link.
+func parseInstanceURL(rawURL string) (string, Location, error) {
+	if strings.HasPrefix(rawURL, "wrld_") {
+		l, err := ParseLocation(rawURL)
+		return "", l, err
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", Location{}, fmt.Errorf("invalid instance URL: %w", err)
+	}
+	query := u.Query()
+	switch {
+	case u.Scheme == "vrchat" && u.Host == "launch":
+		if shortName := query.Get("shortName"); shortName != "" {
+			return shortName, Location{}, nil
+		}
+		l, err := ParseLocation(query.Get("id"))
+		return "", l, err
+	case u.Host == "vrch.at":
+		if shortName := strings.Trim(u.Path, "/"); shortName != "" {
+			return shortName, Location{}, nil
+		}
+	case u.Host == "vrchat.com" || u.Host == "www.vrchat.com":
+		if shortName, ok := strings.CutPrefix(u.Path, "/i/"); ok && shortName != "" {
+			return shortName, Location{}, nil
+		}
+		if u.Path == "/home/launch" {
+			if shortName := query.Get("shortName"); shortName != "" {
+				return shortName, Location{}, nil
+			}
+			l, err := ParseLocation(query.Get("worldId") + ":" + query.Get("instanceId"))
+			return "", l, err
+		}
+	}
+	return "", Location{}, fmt.Errorf("unsupported instance URL: %q", rawURL)
+}
@@ -0,0 +1,137 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseLocation(t *testing.T) {
+	tests := []struct {
+		location string
+		want     Location
+		wantErr  bool
+	}{
+		{
+			location: "wrld_xxx:12345",
+			want:     Location{WorldId: "wrld_xxx", InstanceId: "12345", Name: "12345", Type: InstanceTypePublic, Region: InstanceRegionUs},
+		},
+		{
+			location: "wrld_xxx:12345~hidden(usr_xxx)~region(eu)",
+			want: Location{
+				WorldId: "wrld_xxx", InstanceId: "12345~hidden(usr_xxx)~region(eu)", Name: "12345",
+				Type: InstanceTypeHidden, OwnerId: "usr_xxx", Region: InstanceRegionEu,
+			},
+		},
+		{
+			location: "wrld_xxx:67890~private(usr_xxx)~canRequestInvite~region(jp)~nonce(abc)",
+			want: Location{
+				WorldId: "wrld_xxx", InstanceId: "67890~private(usr_xxx)~canRequestInvite~region(jp)~nonce(abc)", Name: "67890",
+				Type: InstanceTypePrivate, OwnerId: "usr_xxx", CanRequestInvite: true, Region: InstanceRegionJp, Nonce: "abc",
+			},
+		},
+		{
+			location: "wrld_xxx:1~group(grp_xxx)~groupAccessType(plus)~region(use)",
+			want: Location{
+				WorldId: "wrld_xxx", InstanceId: "1~group(grp_xxx)~groupAccessType(plus)~region(use)", Name: "1",
+				Type: InstanceTypeGroup, OwnerId: "grp_xxx", GroupAccessType: GroupAccessTypePlus, Region: InstanceRegionUse,
+			},
+		},
+		{location: "offline", wantErr: true},
+		{location: "traveling", wantErr: true},
+		{location: "wrld_xxx", wantErr: true},
+		{location: "wrld_xxx:", wantErr: true},
+		{location: "usr_xxx:12345", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.location, func(t *testing.T) {
+			got, err := ParseLocation(tt.location)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseLocation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseLocation() = %+v, want %+v", got, tt.want)
+			}
+			if !tt.wantErr && got.String() != tt.location {
+				t.Errorf("String() = %q, want %q", got.String(), tt.location)
+			}
+		})
+	}
+
+	if _, err := ParseLocation("private"); !errors.Is(err, ErrNoLocation) {
+		t.Errorf("ParseLocation(private) error = %v, want ErrNoLocation", err)
+	}
+}
+
+func TestParseInstanceURL(t *testing.T) {
+	tests := []struct {
+		url       string
+		shortName string
+		location  string
+		wantErr   bool
+	}{
+		{url: "https://vrch.at/abcd1234", shortName: "abcd1234"},
+		{url: "https://vrchat.com/i/abcd1234", shortName: "abcd1234"},
+		{url: "vrchat://launch?ref=vrchat.com&id=wrld_xxx:12345~region(eu)&shortName=abcd1234", shortName: "abcd1234"},
+		{url: "vrchat://launch?id=wrld_xxx:12345~region(eu)", location: "wrld_xxx:12345~region(eu)"},
+		{url: "https://vrchat.com/home/launch?worldId=wrld_xxx&instanceId=12345~hidden(usr_xxx)", location: "wrld_xxx:12345~hidden(usr_xxx)"},
+		{url: "https://www.vrchat.com/home/launch?shortName=abcd1234", shortName: "abcd1234"},
+		{url: "wrld_xxx:12345", location: "wrld_xxx:12345"},
+		{url: "https://vrch.at/", wantErr: true},
+		{url: "https://example.com/i/abcd1234", wantErr: true},
+		{url: "https://vrchat.com/home/launch?worldId=wrld_xxx", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			shortName, location, err := parseInstanceURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseInstanceURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if shortName != tt.shortName {
+				t.Errorf("short name = %q, want %q", shortName, tt.shortName)
+			}
+			if tt.location != "" && location.String() != tt.location {
+				t.Errorf("location = %q, want %q", location.String(), tt.location)
+			}
+		})
+	}
+}
+
+func TestResolveInstanceURLEscapesShortName(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	if _, err := NewClient(srv.URL).ResolveInstanceURL(context.Background(), "https://vrch.at/..%2F..%2Fauth%2Fuser"); err != nil {
+		t.Fatalf("ResolveInstanceURL() error = %v", err)
+	}
+	if want := "/instances/s/..%2F..%2Fauth%2Fuser"; path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+}
+
+func TestResolveInstanceURLEscapesInstanceId(t *testing.T) {
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	rawURL := "vrchat://launch?id=wrld_xxx:1/../../auth/user%3Fx~region(eu)"
+	if _, err := NewClient(srv.URL).ResolveInstanceURL(context.Background(), rawURL); err != nil {
+		t.Fatalf("ResolveInstanceURL() error = %v", err)
+	}
+	if want := "/instances/wrld_xxx:1%2F..%2F..%2Fauth%2Fuser%3Fx~region%28eu%29"; path != want {
+		t.Errorf("path = %q, want %q", path, want)
+	}
+}
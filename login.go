@@ -0,0 +1,198 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// TwoFactorMethod is a second factor the API accepts during login.
+type TwoFactorMethod string
+
+const (
+	// TwoFactorTOTP is a code from an authenticator app.
+	TwoFactorTOTP TwoFactorMethod = "totp"
+	// TwoFactorEmailOTP is a code sent to the account's email address.
+	TwoFactorEmailOTP TwoFactorMethod = "emailOtp"
+	// TwoFactorOTP is a recovery code.
+	TwoFactorOTP TwoFactorMethod = "otp"
+)
+
+// MaxCodeAttempts is the number of times LoginWithCodeProvider asks for a code
+// before giving up, unless the CodeProvider implements CodeAttempts.
+const MaxCodeAttempts = 3
+
+var (
+	// ErrTwoFactorRequired is returned when the account requires a second
+	// factor but no code was provided.
+	ErrTwoFactorRequired = errors.New("two-factor authentication required")
+	// ErrInvalidCode is returned when the API rejects every provided code.
+	ErrInvalidCode = errors.New("invalid two-factor authentication code")
+)
+
+// LoginResult is the result of the first login step.
+type LoginResult struct {
+	// User is the logged in user, or nil if a second factor is required.
+	User *CurrentUser
+	// RequiresTwoFactorAuth lists the second factors the account accepts.
+	RequiresTwoFactorAuth []TwoFactorMethod
+}
+
+// RequiresTwoFactor reports whether a second factor must be verified to finish the login.
+func (r *LoginResult) RequiresTwoFactor() bool {
+	return len(r.RequiresTwoFactorAuth) > 0
+}
+
+// CodeRequest describes the code a CodeProvider is asked for.
+type CodeRequest struct {
+	// Methods are the second factors the account accepts.
+	Methods []TwoFactorMethod
+	// Attempt is 0 for the first code and increases every time a code is rejected.
+	Attempt int
+}
+
+// CodeProvider provides second factor codes during login.
+type CodeProvider interface {
+	// Code returns one of req.Methods and the code to verify with it.
+	Code(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error)
+}
+
+// CodeAttempts is implemented by CodeProviders that provide a different number
+// of codes than MaxCodeAttempts, e.g. TOTP with a larger Skew.
+type CodeAttempts interface {
+	// MaxAttempts returns the number of codes the provider can provide.
+	MaxAttempts() int
+}
+
+// CodeProviderFunc is a function that implements CodeProvider, e.g. a prompt for the user.
+type CodeProviderFunc func(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error)
+
+// Code implements CodeProvider.
+func (f CodeProviderFunc) Code(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error) {
+	return f(ctx, req)
+}
+
+// StaticCodeProvider returns a CodeProvider that provides code for method once.
+func StaticCodeProvider(method TwoFactorMethod, code string) CodeProvider {
+	return CodeProviderFunc(func(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error) {
+		if code == "" || req.Attempt > 0 {
+			return "", "", ErrInvalidCode
+		}
+		return method, code, nil
+	})
+}
+
+// Login performs the first login step with username and password. If the
+// result requires a second factor, finish the login with VerifyTwoFactor.
+func (c *Client) Login(ctx context.Context, username, password string) (*LoginResult, error) {
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetBasicAuth(basicAuthEscape(username), basicAuthEscape(password)).
+		Get("/auth/user")
+	if err != nil {
+		return nil, fmt.Errorf("error sending request: %w", err)
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return nil, newAPIError("getCurrentUser", resp)
+	}
+
+	var pending struct {
+		RequiresTwoFactorAuth []TwoFactorMethod `json:"requiresTwoFactorAuth"`
+	}
+	if err := json.Unmarshal(resp.Body(), &pending); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+	if len(pending.RequiresTwoFactorAuth) > 0 {
+		return &LoginResult{RequiresTwoFactorAuth: pending.RequiresTwoFactorAuth}, nil
+	}
+
+	var user CurrentUser
+	if err := json.Unmarshal(resp.Body(), &user); err != nil {
+		return nil, fmt.Errorf("error decoding response: %w", err)
+	}
+	return &LoginResult{User: &user}, nil
+}
+
+// VerifyTwoFactor verifies code with method to finish a login. It reports
+// whether the code was accepted.
+func (c *Client) VerifyTwoFactor(ctx context.Context, method TwoFactorMethod, code string) (bool, error) {
+	var verified bool
+	var err error
+	switch method {
+	case TwoFactorTOTP:
+		var result *Verify2FaResponse
+		if result, err = c.Verify2FaWithContext(ctx, TwoFactorAuthCode{Code: code}); err == nil {
+			verified = result.Verified
+		}
+	case TwoFactorEmailOTP:
+		var result *Verify2FaEmailCodeResponse
+		if result, err = c.Verify2FaEmailCodeWithContext(ctx, TwoFactorEmailCode{Code: code}); err == nil {
+			verified = result.Verified
+		}
+	case TwoFactorOTP:
+		var result *Verify2FaResponse
+		if result, err = c.VerifyRecoveryCodeWithContext(ctx, TwoFactorAuthCode{Code: code}); err == nil {
+			verified = result.Verified
+		}
+	default:
+		return false, fmt.Errorf("unsupported two-factor method: %q", method)
+	}
+	if IsBadRequest(err) {
+		return false, nil
+	}
+	return verified, err
+}
+
+// LoginWithCodeProvider logs in with username and password, asking codes for
+// a second factor if the account requires one.
+func (c *Client) LoginWithCodeProvider(ctx context.Context, username, password string, codes CodeProvider) (*CurrentUser, error) {
+	result, err := c.Login(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+	if !result.RequiresTwoFactor() {
+		return result.User, nil
+	}
+	if codes == nil {
+		return nil, fmt.Errorf("%w: %v", ErrTwoFactorRequired, result.RequiresTwoFactorAuth)
+	}
+
+	attempts := MaxCodeAttempts
+	if a, ok := codes.(CodeAttempts); ok {
+		attempts = a.MaxAttempts()
+	}
+	for attempt := range attempts {
+		method, code, err := codes.Code(ctx, CodeRequest{
+			Methods: result.RequiresTwoFactorAuth,
+			Attempt: attempt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(result.RequiresTwoFactorAuth, method) {
+			return nil, fmt.Errorf("two-factor method %q not accepted, want one of %v", method, result.RequiresTwoFactorAuth)
+		}
+		verified, err := c.VerifyTwoFactor(ctx, method, code)
+		if err != nil {
+			return nil, err
+		}
+		if verified {
+			user, err := c.GetCurrentUserWithContext(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return (*CurrentUser)(user), nil
+		}
+	}
+	return nil, ErrInvalidCode
+}
+
+// basicAuthEscape escapes a credential like JavaScript's encodeURIComponent,
+// as the API expects.
+func basicAuthEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
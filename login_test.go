@@ -0,0 +1,274 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// authServer is a fake of the authentication endpoints. It accepts the
+// password "password" for "user" and, if methods is set, requires the code
+// "123456" with one of them. Other requests need a valid session and are
+// answered by api, or with an empty object.
+type authServer struct {
+	url     string
+	methods []TwoFactorMethod
+	api     http.HandlerFunc
+
+	mu       sync.Mutex
+	session  string
+	sessions int
+	verified bool
+	requests map[string]int
+}
+
+// newAuthServer starts an authServer and returns a client for it.
+func newAuthServer(t *testing.T, methods ...TwoFactorMethod) (*authServer, *Client) {
+	t.Helper()
+	s := &authServer{methods: methods, requests: make(map[string]int)}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	s.url = srv.URL
+	return s, NewClient(srv.URL)
+}
+
+// count returns the number of requests sent to path, e.g. "GET /auth/user".
+func (s *authServer) count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+// expire invalidates the current session.
+func (s *authServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.session = ""
+}
+
+func (s *authServer) valid(r *http.Request) bool {
+	cookie, err := r.Cookie(authCookieName)
+	return err == nil && s.session != "" && cookie.Value == s.session && (len(s.methods) == 0 || s.verified)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"error":{"message":%q,"status_code":%d}}`, message, status)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
+
+func (s *authServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	path := r.Method + " " + r.URL.Path
+	s.requests[path]++
+
+	if username, password, ok := r.BasicAuth(); ok && path == "GET /auth/user" {
+		defer s.mu.Unlock()
+		if username != "user" || password != "password" {
+			writeError(w, http.StatusUnauthorized, "Invalid Username/Email or Password")
+			return
+		}
+		s.sessions++
+		s.session, s.verified = fmt.Sprintf("authcookie_%d", s.sessions), false
+		http.SetCookie(w, &http.Cookie{Name: authCookieName, Value: s.session, Path: "/"})
+		if len(s.methods) > 0 {
+			writeJSON(w, map[string]any{"requiresTwoFactorAuth": s.methods})
+			return
+		}
+		writeJSON(w, CurrentUser{Id: "usr_1"})
+		return
+	}
+
+	if method, ok := strings.CutPrefix(r.URL.Path, "/auth/twofactorauth/"); ok {
+		defer s.mu.Unlock()
+		method = strings.TrimSuffix(method, "/verify")
+		accepted := map[string]TwoFactorMethod{"totp": TwoFactorTOTP, "emailotp": TwoFactorEmailOTP, "otp": TwoFactorOTP}[method]
+		var body TwoFactorAuthCode
+		json.NewDecoder(r.Body).Decode(&body)
+		if cookie, err := r.Cookie(authCookieName); err != nil || cookie.Value != s.session {
+			writeError(w, http.StatusUnauthorized, "Missing Credentials")
+			return
+		}
+		if !slices.Contains(s.methods, accepted) || body.Code != "123456" {
+			writeError(w, http.StatusBadRequest, "Invalid Code")
+			return
+		}
+		s.verified = true
+		http.SetCookie(w, &http.Cookie{Name: twoFactorAuthCookieName, Value: "2fa_" + s.session, Path: "/"})
+		writeJSON(w, Verify2FaResult{Verified: true})
+		return
+	}
+
+	if !s.valid(r) {
+		s.mu.Unlock()
+		writeError(w, http.StatusUnauthorized, `"Missing Credentials"`)
+		return
+	}
+	token := s.session
+	s.mu.Unlock()
+
+	switch {
+	case path == "GET /auth/user":
+		writeJSON(w, CurrentUser{Id: "usr_1"})
+	case path == "GET /auth":
+		writeJSON(w, VerifyAuthTokenResult{Ok: true, Token: token})
+	case s.api != nil:
+		s.api(w, r)
+	default:
+		writeJSON(w, map[string]any{})
+	}
+}
+
+func TestLoginWithCodeProvider(t *testing.T) {
+	code := func(method TwoFactorMethod, codes ...string) CodeProvider {
+		return CodeProviderFunc(func(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error) {
+			if req.Attempt >= len(codes) {
+				return "", "", ErrInvalidCode
+			}
+			return method, codes[req.Attempt], nil
+		})
+	}
+
+	tests := []struct {
+		name     string
+		methods  []TwoFactorMethod
+		codes    CodeProvider
+		verify   string
+		verifies int
+		err      error
+	}{
+		{
+			name: "no two-factor authentication",
+		},
+		{
+			name:     "totp",
+			methods:  []TwoFactorMethod{TwoFactorTOTP, TwoFactorOTP},
+			codes:    StaticCodeProvider(TwoFactorTOTP, "123456"),
+			verify:   "POST /auth/twofactorauth/totp/verify",
+			verifies: 1,
+		},
+		{
+			name:     "email OTP",
+			methods:  []TwoFactorMethod{TwoFactorEmailOTP},
+			codes:    code(TwoFactorEmailOTP, "123456"),
+			verify:   "POST /auth/twofactorauth/emailotp/verify",
+			verifies: 1,
+		},
+		{
+			name:     "recovery code",
+			methods:  []TwoFactorMethod{TwoFactorTOTP, TwoFactorOTP},
+			codes:    code(TwoFactorOTP, "123456"),
+			verify:   "POST /auth/twofactorauth/otp/verify",
+			verifies: 1,
+		},
+		{
+			name:     "rejected code then retry",
+			methods:  []TwoFactorMethod{TwoFactorTOTP},
+			codes:    code(TwoFactorTOTP, "000000", "123456"),
+			verify:   "POST /auth/twofactorauth/totp/verify",
+			verifies: 2,
+		},
+		{
+			name:     "out of attempts",
+			methods:  []TwoFactorMethod{TwoFactorTOTP},
+			codes:    code(TwoFactorTOTP, "000000", "000000", "000000", "123456"),
+			verify:   "POST /auth/twofactorauth/totp/verify",
+			verifies: MaxCodeAttempts,
+			err:      ErrInvalidCode,
+		},
+		{
+			name:    "no code provider",
+			methods: []TwoFactorMethod{TwoFactorEmailOTP},
+			err:     ErrTwoFactorRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, client := newAuthServer(t, tt.methods...)
+			user, err := client.LoginWithCodeProvider(context.Background(), "user", "password", tt.codes)
+			if tt.err != nil {
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("LoginWithCodeProvider() error = %v, want %v", err, tt.err)
+				}
+			} else if err != nil || user == nil || user.Id != "usr_1" {
+				t.Fatalf("LoginWithCodeProvider() = %v, %v, want usr_1", user, err)
+			}
+			if tt.verify != "" {
+				if n := server.count(tt.verify); n != tt.verifies {
+					t.Errorf("%s sent %d times, want %d", tt.verify, n, tt.verifies)
+				}
+			}
+		})
+	}
+}
+
+func TestLoginRequiresTwoFactor(t *testing.T) {
+	_, client := newAuthServer(t, TwoFactorTOTP, TwoFactorOTP)
+	result, err := client.Login(context.Background(), "user", "password")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if !result.RequiresTwoFactor() || result.User != nil {
+		t.Errorf("Login() = %+v, want a second factor", result)
+	}
+	if want := []TwoFactorMethod{TwoFactorTOTP, TwoFactorOTP}; !slices.Equal(result.RequiresTwoFactorAuth, want) {
+		t.Errorf("RequiresTwoFactorAuth = %v, want %v", result.RequiresTwoFactorAuth, want)
+	}
+
+	// A 400 is a rejected code, not an error
+	if ok, err := client.VerifyTwoFactor(context.Background(), TwoFactorTOTP, "000000"); ok || err != nil {
+		t.Errorf("VerifyTwoFactor(wrong code) = %v, %v, want false, nil", ok, err)
+	}
+	if ok, err := client.VerifyTwoFactor(context.Background(), TwoFactorTOTP, "123456"); !ok || err != nil {
+		t.Errorf("VerifyTwoFactor() = %v, %v, want true, nil", ok, err)
+	}
+}
+
+func TestLoginInvalidPassword(t *testing.T) {
+	_, client := newAuthServer(t)
+	if _, err := client.Login(context.Background(), "user", "wrong"); !IsUnauthorized(err) {
+		t.Errorf("Login() error = %v, want unauthorized", err)
+	}
+}
+
+func TestLoginUnacceptedMethod(t *testing.T) {
+	_, client := newAuthServer(t, TwoFactorEmailOTP)
+	_, err := client.LoginWithCodeProvider(context.Background(), "user", "password", StaticCodeProvider(TwoFactorTOTP, "123456"))
+	if err == nil || errors.Is(err, ErrInvalidCode) {
+		t.Errorf("LoginWithCodeProvider() error = %v, want method not accepted", err)
+	}
+}
+
+func TestVerifyTwoFactorSendsCookie(t *testing.T) {
+	server, client := newAuthServer(t, TwoFactorTOTP)
+	var cookie string
+	server.api = func(w http.ResponseWriter, r *http.Request) {
+		if c, err := r.Cookie(twoFactorAuthCookieName); err == nil {
+			cookie = c.Value
+		}
+		writeJSON(w, map[string]any{})
+	}
+
+	if _, err := client.LoginWithCodeProvider(context.Background(), "user", "password", StaticCodeProvider(TwoFactorTOTP, "123456")); err != nil {
+		t.Fatalf("LoginWithCodeProvider() error = %v", err)
+	}
+	if _, err := client.GetConfigWithContext(context.Background()); err != nil {
+		t.Fatalf("GetConfig() error = %v", err)
+	}
+	if want := "2fa_authcookie_1"; cookie != want {
+		t.Errorf("twoFactorAuth cookie = %q, want %q", cookie, want)
+	}
+}
@@ -0,0 +1,477 @@
+package vrchat
+
+// operationErrors maps an operation ID and an error status code to the typed
+// error defined for that response in the API specification.
+var operationErrors = map[string]map[int]func() any{
+	"checkUserExists": {
+		400: func() any { return new(MissingParameterError) },
+	},
+	"getCurrentUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"verify2FA": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"verifyRecoveryCode": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"verify2FAEmailCode": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"verifyAuthToken": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"logout": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deleteUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getOwnAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(AvatarSeeOtherUserCurrentAvatarError) },
+	},
+	"createAvatar": {
+		401: func() any { return new(FeaturedSetNotAdminError) },
+	},
+	"searchAvatars": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deleteAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(AvatarNotFoundError) },
+	},
+	"getAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(AvatarNotFoundError) },
+	},
+	"updateAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(AvatarNotFoundError) },
+	},
+	"selectAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(AvatarNotFoundError) },
+	},
+	"selectFallbackAvatar": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(AvatarNotTaggedAsFallbackError) },
+		404: func() any { return new(AvatarNotFoundError) },
+	},
+	"getFavoritedAvatars": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(AvatarSeeOtherUserFavoritesError) },
+	},
+	"getSteamTransactions": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getSteamTransaction": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getCurrentSubscriptions": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getSubscriptions": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getLicenseGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getFavorites": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"addFavorite": {
+		400: func() any { return new(FavoriteAddAlreadyFavoritedError) },
+		403: func() any { return new(FavoriteAddNotFriendsError) },
+	},
+	"removeFavorite": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(FavoriteNotFoundError) },
+	},
+	"getFavorite": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(FavoriteNotFoundError) },
+	},
+	"getFavoriteGroups": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getFile": {
+		404: func() any { return new(FileNotFoundError) },
+	},
+	"deleteFile": {
+		404: func() any { return new(FileDeletedError) },
+	},
+	"deleteFileVersion": {
+		400: func() any { return new(FileVersionDeleteInitialError) },
+		500: func() any { return new(FileVersionDeleteMiddleError) },
+	},
+	"downloadFileVersion": {
+		404: func() any { return new(FileNotFoundError) },
+	},
+	"startFileDataUpload": {
+		400: func() any { return new(FileUploadAlreadyFinishedError) },
+	},
+	"getFriends": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deleteFriendRequest": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(DeleteFriendRequestError) },
+	},
+	"friend": {
+		400: func() any { return new(FriendBadRequestError) },
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(UserDoesntExistError) },
+	},
+	"getFriendStatus": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"unfriend": {
+		400: func() any { return new(NotFriendsError) },
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"searchGroups": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"createGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"updateGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroupAnnouncement": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupAnnouncements": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"createGroupAnnouncement": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupAuditLogs": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupBans": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(NoPermission) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"banGroupMember": {
+		400: func() any { return new(BanGroupMemberBadRequestError) },
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"unbanGroupMember": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"createGroupGallery": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroupGallery": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupGalleryImages": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"updateGroupGallery": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"addGroupGalleryImage": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroupGalleryImage": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(GroupGalleryImageDeleteForbiddenError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupInstances": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupInvites": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"createGroupInvite": {
+		400: func() any { return new(GroupInviteBadRequestError) },
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(GroupInviteForbiddenError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroupInvite": {
+		400: func() any { return new(DeleteGroupInviteBadRequestError) },
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"joinGroup": {
+		400: func() any { return new(GroupAlreadyMemberError) },
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"leaveGroup": {
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupMembers": {
+		400: func() any { return new(UsersInvalidSearchError) },
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"kickGroupMember": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupMember": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"updateGroupMember": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"removeGroupMemberRole": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"addGroupMemberRole": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupPermissions": {
+		400: func() any { return new(UsersInvalidSearchError) },
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupPost": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"addGroupPost": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deleteGroupPost": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"updateGroupPost": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"cancelGroupRequest": {
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupRequests": {
+		400: func() any { return new(GroupJoinRequestResponseBadRequestError) },
+		403: func() any { return new(GroupNotMemberError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"respondGroupJoinRequest": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"getGroupRoles": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"createGroupRole": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotFoundError) },
+	},
+	"deleteGroupRole": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(GroupNotMemberError) },
+	},
+	"updateGroupRole": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"inviteUser": {
+		403: func() any { return new(InviteMustBeFriendsError) },
+	},
+	"inviteMyselfTo": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(InstanceNotFoundError) },
+	},
+	"requestInvite": {
+		403: func() any { return new(InviteMustBeFriendsError) },
+	},
+	"respondInvite": {
+		400: func() any { return new(InviteResponse400Error) },
+	},
+	"getInviteMessages": {
+		400: func() any { return new(InviteMessageInvalidSlotNumberError) },
+		401: func() any { return new(NotAuthorizedActionError) },
+	},
+	"resetInviteMessage": {
+		400: func() any { return new(InviteMessageInvalidSlotNumberError) },
+		401: func() any { return new(NotAuthorizedActionError) },
+		404: func() any { return new(InviteMessageNoEntryForSlotError) },
+		429: func() any { return new(InviteMessageUpdateRateLimitError) },
+	},
+	"getInviteMessage": {
+		400: func() any { return new(InviteMessageGetNegativeSlotError) },
+		401: func() any { return new(NotAuthorizedActionError) },
+		404: func() any { return new(InviteMessageGetTooHighSlotError) },
+	},
+	"updateInviteMessage": {
+		400: func() any { return new(InviteMessageInvalidSlotNumberError) },
+		401: func() any { return new(NotAuthorizedActionError) },
+		429: func() any { return new(InviteMessageUpdateRateLimitError) },
+	},
+	"createInstance": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"closeInstance": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(InstanceCloseForbiddenError) },
+		404: func() any { return new(InstanceNotFoundError) },
+	},
+	"getInstance": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getShortName": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"sendSelfInvite": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getInstanceByShortName": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(InstanceNotFoundError) },
+	},
+	"getNotifications": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"acceptFriendRequest": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(AcceptFriendRequestError) },
+	},
+	"markNotificationAsRead": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deleteNotification": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"clearNotifications": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getAssignedPermissions": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getPermission": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"clearAllPlayerModerations": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getPlayerModerations": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"moderateUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"deletePlayerModeration": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(PlayerModerationDeleteOthersError) },
+	},
+	"getPlayerModeration": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(PlayerModerationNotFoundError) },
+	},
+	"unmoderateUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getCSS": {
+		400: func() any { return new(DownloadSourceCodeAccessError) },
+	},
+	"getJavaScript": {
+		400: func() any { return new(DownloadSourceCodeAccessError) },
+	},
+	"searchUsers": {
+		400: func() any { return new(UsersInvalidSearchError) },
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getUserByName": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"updateUser": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getUserGroups": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getUserGroupRequests": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getUserRepresentedGroup": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"searchWorlds": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"createWorld": {
+		400: func() any { return new(WorldCreateNotAllowedYetError) },
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getActiveWorlds": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+	"getFavoritedWorlds": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(WorldSeeOtherUserFavoritesError) },
+	},
+	"getRecentWorlds": {
+		401: func() any { return new(MissingCredentialsError) },
+		403: func() any { return new(WorldSeeOtherUserRecentsError) },
+	},
+	"deleteWorld": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"getWorld": {
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"updateWorld": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"getWorldMetadata": {
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"unpublishWorld": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"getWorldPublishStatus": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"publishWorld": {
+		401: func() any { return new(MissingCredentialsError) },
+		404: func() any { return new(WorldNotFoundError) },
+	},
+	"getWorldInstance": {
+		401: func() any { return new(MissingCredentialsError) },
+	},
+}
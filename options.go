@@ -0,0 +1,75 @@
+package vrchat
+
+import (
+	"net/http"
+	"time"
+)
+
+// ClientOption configures a Client created by NewClient.
+type ClientOption func(*clientOptions)
+
+type clientOptions struct {
+	httpClient *http.Client
+	userAgent  string
+	headers    map[string]string
+	timeout    time.Duration
+	proxy      string
+	debug      bool
+
+	retryPolicy *RetryPolicy
+	rateLimiter RateLimiter
+	credentials CredentialsProvider
+}
+
+// WithHTTPClient makes the client send requests through a copy of hc, e.g. to
+// use a custom transport. Other options such as WithTimeout and WithProxy are
+// applied on top of it. The session cookies are kept in hc's cookie jar, or in
+// a new one if hc has none.
+func WithHTTPClient(hc *http.Client) ClientOption {
+	return func(o *clientOptions) {
+		o.httpClient = hc
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+// VRChat expects it to identify the application, e.g. "my-bot/1.0 me@example.com".
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) {
+		o.userAgent = userAgent
+	}
+}
+
+// WithHeader sets an additional header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(o *clientOptions) {
+		if o.headers == nil {
+			o.headers = make(map[string]string)
+		}
+		o.headers[key] = value
+	}
+}
+
+// WithTimeout sets the timeout of a single HTTP request, including reading
+// the response body. It does not apply to the file data sent by UploadFile,
+// but it does to the body streamed by DownloadFile.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithProxy routes requests through the proxy at proxyURL, e.g. "http://127.0.0.1:8080".
+// It has no effect if the transport of the client passed to WithHTTPClient is
+// not an *http.Transport; configure the proxy on that transport instead.
+func WithProxy(proxyURL string) ClientOption {
+	return func(o *clientOptions) {
+		o.proxy = proxyURL
+	}
+}
+
+// WithDebug enables logging of requests and responses.
+func WithDebug(debug bool) ClientOption {
+	return func(o *clientOptions) {
+		o.debug = debug
+	}
+}
@@ -0,0 +1,291 @@
+package vrchat
+
+import (
+	"context"
+	"iter"
+)
+
+// DefaultPageSize is the number of items requested per page unless overridden
+// by PageOptions.PageSize. It is the largest page size the API accepts.
+const DefaultPageSize = 100
+
+// PageOptions configures the iterators of paginated operations.
+type PageOptions struct {
+	// PageSize is the number of items requested per page, DefaultPageSize if
+	// zero or larger.
+	PageSize int64
+	// MaxItems stops the iteration after this many items if positive.
+	MaxItems int
+}
+
+// paginate returns an iterator over the items of the pages returned by fetch,
+// starting at offset. Iteration stops after the first page that is shorter
+// than requested or that fetch reports as the last one, after MaxItems items,
+// or after the first error.
+func paginate[T any](offset *int64, opts PageOptions, fetch func(n, offset int64) ([]T, bool, error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		pageSize := opts.PageSize
+		if pageSize <= 0 || pageSize > DefaultPageSize {
+			pageSize = DefaultPageSize
+		}
+		next := int64(0)
+		if offset != nil {
+			next = *offset
+		}
+
+		count := 0
+		for {
+			n := pageSize
+			if opts.MaxItems > 0 {
+				n = min(n, int64(opts.MaxItems-count))
+			}
+			page, more, err := fetch(n, next)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+				count++
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+			}
+			if !more || int64(len(page)) < n {
+				return
+			}
+			next += int64(len(page))
+		}
+	}
+}
+
+// SearchAvatarsIter returns an iterator over the results of SearchAvatars, fetching pages as needed.
+func (c *Client) SearchAvatarsIter(ctx context.Context, params SearchAvatarsParams, opts PageOptions) iter.Seq2[Avatar, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]Avatar, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.SearchAvatarsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFavoritedAvatarsIter returns an iterator over the results of GetFavoritedAvatars, fetching pages as needed.
+func (c *Client) GetFavoritedAvatarsIter(ctx context.Context, params GetFavoritedAvatarsParams, opts PageOptions) iter.Seq2[Avatar, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]Avatar, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFavoritedAvatarsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFavoritesIter returns an iterator over the results of GetFavorites, fetching pages as needed.
+func (c *Client) GetFavoritesIter(ctx context.Context, params GetFavoritesParams, opts PageOptions) iter.Seq2[Favorite, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]Favorite, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFavoritesWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFavoriteGroupsIter returns an iterator over the results of GetFavoriteGroups, fetching pages as needed.
+func (c *Client) GetFavoriteGroupsIter(ctx context.Context, params GetFavoriteGroupsParams, opts PageOptions) iter.Seq2[FavoriteGroup, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]FavoriteGroup, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFavoriteGroupsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFilesIter returns an iterator over the results of GetFiles, fetching pages as needed.
+func (c *Client) GetFilesIter(ctx context.Context, params GetFilesParams, opts PageOptions) iter.Seq2[File, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]File, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFilesWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFriendsIter returns an iterator over the results of GetFriends, fetching pages as needed.
+func (c *Client) GetFriendsIter(ctx context.Context, params GetFriendsParams, opts PageOptions) iter.Seq2[LimitedUser, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedUser, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFriendsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// SearchGroupsIter returns an iterator over the results of SearchGroups, fetching pages as needed.
+func (c *Client) SearchGroupsIter(ctx context.Context, params SearchGroupsParams, opts PageOptions) iter.Seq2[LimitedGroup, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedGroup, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.SearchGroupsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetGroupAuditLogsIter returns an iterator over the results of GetGroupAuditLogs, fetching pages as needed.
+func (c *Client) GetGroupAuditLogsIter(ctx context.Context, params GetGroupAuditLogsParams, opts PageOptions) iter.Seq2[GroupAuditLogEntry, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupAuditLogEntry, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupAuditLogsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return page.Results, page.HasNext, nil
+	})
+}
+
+// GetGroupBansIter returns an iterator over the results of GetGroupBans, fetching pages as needed.
+func (c *Client) GetGroupBansIter(ctx context.Context, params GetGroupBansParams, opts PageOptions) iter.Seq2[GroupMember, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupMember, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupBansWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetGroupGalleryImagesIter returns an iterator over the results of GetGroupGalleryImages, fetching pages as needed.
+func (c *Client) GetGroupGalleryImagesIter(ctx context.Context, params GetGroupGalleryImagesParams, opts PageOptions) iter.Seq2[GroupGalleryImage, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupGalleryImage, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupGalleryImagesWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetGroupInvitesIter returns an iterator over the results of GetGroupInvites, fetching pages as needed.
+func (c *Client) GetGroupInvitesIter(ctx context.Context, params GetGroupInvitesParams, opts PageOptions) iter.Seq2[GroupMember, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupMember, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupInvitesWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetGroupMembersIter returns an iterator over the results of GetGroupMembers, fetching pages as needed.
+func (c *Client) GetGroupMembersIter(ctx context.Context, params GetGroupMembersParams, opts PageOptions) iter.Seq2[GroupMember, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupMember, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupMembersWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetGroupRequestsIter returns an iterator over the results of GetGroupRequests, fetching pages as needed.
+func (c *Client) GetGroupRequestsIter(ctx context.Context, params GetGroupRequestsParams, opts PageOptions) iter.Seq2[GroupMember, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]GroupMember, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetGroupRequestsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetNotificationsIter returns an iterator over the results of GetNotifications, fetching pages as needed.
+func (c *Client) GetNotificationsIter(ctx context.Context, params GetNotificationsParams, opts PageOptions) iter.Seq2[Notification, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]Notification, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetNotificationsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// SearchUsersIter returns an iterator over the results of SearchUsers, fetching pages as needed.
+func (c *Client) SearchUsersIter(ctx context.Context, params SearchUsersParams, opts PageOptions) iter.Seq2[LimitedUser, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedUser, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.SearchUsersWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// SearchWorldsIter returns an iterator over the results of SearchWorlds, fetching pages as needed.
+func (c *Client) SearchWorldsIter(ctx context.Context, params SearchWorldsParams, opts PageOptions) iter.Seq2[LimitedWorld, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedWorld, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.SearchWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetActiveWorldsIter returns an iterator over the results of GetActiveWorlds, fetching pages as needed.
+func (c *Client) GetActiveWorldsIter(ctx context.Context, params GetActiveWorldsParams, opts PageOptions) iter.Seq2[LimitedWorld, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedWorld, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetActiveWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetFavoritedWorldsIter returns an iterator over the results of GetFavoritedWorlds, fetching pages as needed.
+func (c *Client) GetFavoritedWorldsIter(ctx context.Context, params GetFavoritedWorldsParams, opts PageOptions) iter.Seq2[LimitedWorld, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedWorld, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetFavoritedWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
+
+// GetRecentWorldsIter returns an iterator over the results of GetRecentWorlds, fetching pages as needed.
+func (c *Client) GetRecentWorldsIter(ctx context.Context, params GetRecentWorldsParams, opts PageOptions) iter.Seq2[LimitedWorld, error] {
+	return paginate(params.Offset, opts, func(n, offset int64) ([]LimitedWorld, bool, error) {
+		params.N, params.Offset = &n, &offset
+		page, err := c.GetRecentWorldsWithContext(ctx, params)
+		if err != nil {
+			return nil, false, err
+		}
+		return *page, true, nil
+	})
+}
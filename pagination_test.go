@@ -0,0 +1,188 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+// newFriendsServer returns a client for a server that serves total friends
+// and records the n and offset of every request.
+func newFriendsServer(t *testing.T, total int) (*Client, *[][2]int) {
+	t.Helper()
+	var requests [][2]int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := strconv.Atoi(r.URL.Query().Get("n"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		requests = append(requests, [2]int{n, offset})
+
+		users := []LimitedUser{}
+		for i := offset; i < min(offset+n, total); i++ {
+			users = append(users, LimitedUser{Id: UserId(fmt.Sprintf("usr_%d", i))})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(users)
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL), &requests
+}
+
+func TestPaginationIterator(t *testing.T) {
+	tests := []struct {
+		name     string
+		total    int
+		offset   *int64
+		opts     PageOptions
+		want     int
+		requests [][2]int
+	}{
+		{
+			name:     "short last page",
+			total:    5,
+			opts:     PageOptions{PageSize: 2},
+			want:     5,
+			requests: [][2]int{{2, 0}, {2, 2}, {2, 4}},
+		},
+		{
+			name:     "empty last page",
+			total:    4,
+			opts:     PageOptions{PageSize: 2},
+			want:     4,
+			requests: [][2]int{{2, 0}, {2, 2}, {2, 4}},
+		},
+		{
+			name:     "max items",
+			total:    10,
+			opts:     PageOptions{PageSize: 4, MaxItems: 6},
+			want:     6,
+			requests: [][2]int{{4, 0}, {2, 4}},
+		},
+		{
+			name:     "page size above maximum",
+			total:    150,
+			opts:     PageOptions{PageSize: 500},
+			want:     150,
+			requests: [][2]int{{DefaultPageSize, 0}, {DefaultPageSize, 100}},
+		},
+		{
+			name:     "start offset",
+			total:    5,
+			offset:   Ptr[int64](3),
+			opts:     PageOptions{},
+			want:     2,
+			requests: [][2]int{{DefaultPageSize, 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, requests := newFriendsServer(t, tt.total)
+
+			var got []LimitedUser
+			for user, err := range client.GetFriendsIter(context.Background(), GetFriendsParams{Offset: tt.offset}, tt.opts) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				got = append(got, user)
+			}
+			if len(got) != tt.want {
+				t.Errorf("got %d items, want %d", len(got), tt.want)
+			}
+			if !reflect.DeepEqual(*requests, tt.requests) {
+				t.Errorf("requests (n, offset) = %v, want %v", *requests, tt.requests)
+			}
+		})
+	}
+}
+
+func TestPaginationIteratorBreak(t *testing.T) {
+	client, requests := newFriendsServer(t, 10)
+	count := 0
+	for _, err := range client.GetFriendsIter(context.Background(), GetFriendsParams{}, PageOptions{PageSize: 3}) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		count++
+		if count == 2 {
+			break
+		}
+	}
+	if len(*requests) != 1 {
+		t.Errorf("got %d requests, want 1", len(*requests))
+	}
+}
+
+func TestPaginationIteratorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(srv.Close)
+
+	var errs int
+	for _, err := range NewClient(srv.URL).GetFriendsIter(context.Background(), GetFriendsParams{}, PageOptions{}) {
+		if !IsUnauthorized(err) {
+			t.Errorf("error = %v, want unauthorized", err)
+		}
+		errs++
+	}
+	if errs != 1 {
+		t.Errorf("got %d errors, want 1", errs)
+	}
+}
+
+func TestPaginationIteratorReuse(t *testing.T) {
+	client, requests := newFriendsServer(t, 3)
+	seq := client.GetFriendsIter(context.Background(), GetFriendsParams{}, PageOptions{PageSize: 2})
+	for i := range 2 {
+		count := 0
+		for _, err := range seq {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+		}
+		if count != 3 {
+			t.Errorf("range %d: got %d items, want 3", i, count)
+		}
+	}
+	if want := [][2]int{{2, 0}, {2, 2}, {2, 0}, {2, 2}}; !reflect.DeepEqual(*requests, want) {
+		t.Errorf("requests (n, offset) = %v, want %v", *requests, want)
+	}
+}
+
+func TestGroupAuditLogsIterHasNext(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		// Full pages, but the second one is the last
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(PaginatedGroupAuditLogEntryList{
+			HasNext: offset == 0,
+			Results: []GroupAuditLogEntry{{}, {}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	seq := NewClient(srv.URL).GetGroupAuditLogsIter(context.Background(), GetGroupAuditLogsParams{GroupId: "grp_1"}, PageOptions{PageSize: 2})
+	for i := range 2 {
+		count := 0
+		for _, err := range seq {
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			count++
+		}
+		if count != 4 {
+			t.Errorf("range %d: got %d items, want 4", i, count)
+		}
+	}
+	if requests != 4 {
+		t.Errorf("got %d requests, want 4", requests)
+	}
+}
@@ -0,0 +1,60 @@
+package vrchat
+
+import (
+	"context"
+)
+
+// Player moderation types that are accepted by the API but missing from the specification.
+const (
+	PlayerModerationTypeHideAvatar PlayerModerationType = "hideAvatar"
+	PlayerModerationTypeShowAvatar PlayerModerationType = "showAvatar"
+)
+
+// BlockUser blocks the user with userId.
+func (c *Client) BlockUser(ctx context.Context, userId UserId) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeBlock})
+}
+
+// UnblockUser removes the block of the user with userId.
+func (c *Client) UnblockUser(ctx context.Context, userId UserId) error {
+	_, err := c.UnmoderateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeBlock})
+	return err
+}
+
+// MuteUser mutes the user with userId.
+func (c *Client) MuteUser(ctx context.Context, userId UserId) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeMute})
+}
+
+// UnmuteUser removes the mute of the user with userId.
+func (c *Client) UnmuteUser(ctx context.Context, userId UserId) error {
+	_, err := c.UnmoderateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeMute})
+	return err
+}
+
+// HideUserAvatar hides the avatar of the user with userId.
+func (c *Client) HideUserAvatar(ctx context.Context, userId UserId) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeHideAvatar})
+}
+
+// ShowUserAvatar removes the hidden avatar moderation of the user with userId.
+func (c *Client) ShowUserAvatar(ctx context.Context, userId UserId) error {
+	_, err := c.UnmoderateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeHideAvatar})
+	return err
+}
+
+// DisableUserInteraction prevents the user with userId from interacting with you.
+func (c *Client) DisableUserInteraction(ctx context.Context, userId UserId) (*PlayerModerationResponse, error) {
+	return c.ModerateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeInteractOff})
+}
+
+// EnableUserInteraction removes the interaction block of the user with userId.
+func (c *Client) EnableUserInteraction(ctx context.Context, userId UserId) error {
+	_, err := c.UnmoderateUserWithContext(ctx, ModerateUserRequest{Moderated: userId, Type: PlayerModerationTypeInteractOff})
+	return err
+}
+
+// GetUserModerations returns your player moderations of the user with userId.
+func (c *Client) GetUserModerations(ctx context.Context, userId UserId) (*PlayerModerationListResponse, error) {
+	return c.GetPlayerModerationsWithContext(ctx, GetPlayerModerationsParams{TargetUserId: Ptr(string(userId))})
+}
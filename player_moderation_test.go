@@ -0,0 +1,69 @@
+package vrchat
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestPlayerModerationRequests(t *testing.T) {
+	ctx := context.Background()
+	runRequestTests(t, []requestTest{
+		{
+			name: "BlockUser",
+			call: func(c *Client) error {
+				_, err := c.BlockUser(ctx, "usr_1")
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/auth/user/playermoderations",
+			body:   `{"moderated":"usr_1","type":"block"}`,
+		},
+		{
+			name:   "UnmuteUser",
+			call:   func(c *Client) error { return c.UnmuteUser(ctx, "usr_1") },
+			method: http.MethodPut,
+			path:   "/auth/user/unplayermoderate",
+			body:   `{"moderated":"usr_1","type":"mute"}`,
+		},
+		{
+			name: "HideUserAvatar",
+			call: func(c *Client) error {
+				_, err := c.HideUserAvatar(ctx, "usr_1")
+				return err
+			},
+			method: http.MethodPost,
+			path:   "/auth/user/playermoderations",
+			body:   `{"moderated":"usr_1","type":"hideAvatar"}`,
+		},
+		{
+			name: "GetUserModerations",
+			call: func(c *Client) error {
+				_, err := c.GetUserModerations(ctx, "usr_1")
+				return err
+			},
+			method:   http.MethodGet,
+			path:     "/auth/user/playermoderations",
+			query:    "targetUserId=usr_1",
+			response: `[]`,
+		},
+		{
+			name: "GetPlayerModeration",
+			call: func(c *Client) error {
+				_, err := c.GetPlayerModeration(GetPlayerModerationParams{PlayerModerationId: "pmod_1"})
+				return err
+			},
+			method: http.MethodGet,
+			path:   "/auth/user/playermoderations/pmod_1",
+		},
+		{
+			name: "DeletePlayerModeration",
+			call: func(c *Client) error {
+				_, err := c.DeletePlayerModeration(DeletePlayerModerationParams{PlayerModerationId: "pmod_1"})
+				return err
+			},
+			method: http.MethodDelete,
+			path:   "/auth/user/playermoderations/pmod_1",
+		},
+	})
+}
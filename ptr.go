@@ -0,0 +1,9 @@
+package vrchat
+
+// Ptr returns a pointer to v. Optional fields of request bodies are pointers,
+// so that unset fields are omitted while zero values can still be sent:
+//
+//	vrchat.UpdateAvatarRequest{Description: vrchat.Ptr("")}
+func Ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,179 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// ErrRateLimited is returned when a fail-fast RateLimiter rejects a request.
+var ErrRateLimited = errors.New("client-side rate limit exceeded")
+
+// RateLimiter limits the requests sent by a Client. Requests are grouped by
+// endpoint, see EndpointGroup.
+type RateLimiter interface {
+	// Wait blocks until a request to group may be sent, or returns an error
+	// if it may not be sent at all.
+	Wait(ctx context.Context, group string) error
+	// WaitTime returns how long a request to group would currently have to wait.
+	WaitTime(group string) time.Duration
+}
+
+// WithRateLimiter makes the client acquire a permit from l before every
+// request, including retries.
+func WithRateLimiter(l RateLimiter) ClientOption {
+	return func(o *clientOptions) {
+		o.rateLimiter = l
+	}
+}
+
+func rateLimitMiddleware(l RateLimiter) resty.RequestMiddleware {
+	return func(_ *resty.Client, r *resty.Request) error {
+		return l.Wait(r.Context(), EndpointGroup(r.URL))
+	}
+}
+
+// EndpointGroup returns the rate limit group of an API path, which is its
+// first path segment after the API base, e.g. "users" for "/users/usr_xxx/friends".
+func EndpointGroup(path string) string {
+	if u, err := url.Parse(path); err == nil {
+		path = u.Path
+	}
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimPrefix(path, "api/1/")
+	group, _, _ := strings.Cut(path, "/")
+	return group
+}
+
+// Limit is the budget of a token bucket: one request every Every, with bursts
+// of up to Burst requests.
+type Limit struct {
+	Every time.Duration
+	Burst int
+}
+
+// TokenBucketConfig configures a TokenBucketLimiter.
+type TokenBucketConfig struct {
+	// Default is the limit of groups without an entry in Groups. A zero Default
+	// leaves those groups unlimited, so callers that do not supply their own
+	// budget should start from DefaultTokenBucketConfig.
+	Default Limit
+	// Groups are the limits of individual endpoint groups, keyed by EndpointGroup.
+	Groups map[string]Limit
+	// FailFast makes Wait return ErrRateLimited instead of blocking.
+	FailFast bool
+}
+
+// DefaultTokenBucketConfig returns the budget of VRChat's API guideline "Do not
+// make queries to the API more than once per 60 seconds", applied per endpoint
+// group: one request per minute, with bursts of up to 5 requests so that a
+// login with two-factor authentication is not delayed.
+func DefaultTokenBucketConfig() TokenBucketConfig {
+	return TokenBucketConfig{
+		Default: Limit{Every: time.Minute, Burst: 5},
+	}
+}
+
+// TokenBucketLimiter is a RateLimiter with one token bucket per endpoint group.
+type TokenBucketLimiter struct {
+	config  TokenBucketConfig
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+}
+
+// NewTokenBucketLimiter creates a TokenBucketLimiter with full buckets.
+func NewTokenBucketLimiter(config TokenBucketConfig) *TokenBucketLimiter {
+	return &TokenBucketLimiter{
+		config:  config,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// Wait implements RateLimiter.
+func (l *TokenBucketLimiter) Wait(ctx context.Context, group string) error {
+	l.mu.Lock()
+	b := l.bucket(group)
+	if b == nil {
+		l.mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	wait := b.wait(now)
+	if wait > 0 && l.config.FailFast {
+		l.mu.Unlock()
+		return fmt.Errorf("%w: %s: retry in %v", ErrRateLimited, group, wait)
+	}
+	// Reserve the token now so that concurrent waiters queue up behind us
+	b.tokens--
+	l.mu.Unlock()
+
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// WaitTime implements RateLimiter.
+func (l *TokenBucketLimiter) WaitTime(group string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(group)
+	if b == nil {
+		return 0
+	}
+	return max(b.wait(time.Now()), 0)
+}
+
+func (l *TokenBucketLimiter) bucket(group string) *tokenBucket {
+	if b, ok := l.buckets[group]; ok {
+		return b
+	}
+	limit, ok := l.config.Groups[group]
+	if !ok {
+		limit = l.config.Default
+	}
+	if limit.Every <= 0 {
+		return nil
+	}
+	limit.Burst = max(limit.Burst, 1)
+	b := &tokenBucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   time.Now(),
+	}
+	l.buckets[group] = b
+	return b
+}
+
+// wait refills the bucket up to now and returns how long until a token is available.
+func (b *tokenBucket) wait(now time.Time) time.Duration {
+	elapsed := now.Sub(b.last)
+	b.last = now
+	b.tokens = min(b.tokens+float64(elapsed)/float64(b.limit.Every), float64(b.limit.Burst))
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration((1 - b.tokens) * float64(b.limit.Every))
+}
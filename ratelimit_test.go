@@ -0,0 +1,147 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketLimiterBlocks(t *testing.T) {
+	limiter := NewTokenBucketLimiter(TokenBucketConfig{Default: Limit{Every: 50 * time.Millisecond, Burst: 2}})
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		if err := limiter.Wait(ctx, "users"); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	// The burst is sent at once, the third request waits for a refill
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("third request waited %v, want about 50ms", elapsed)
+	}
+}
+
+func TestDefaultTokenBucketConfig(t *testing.T) {
+	config := DefaultTokenBucketConfig()
+	config.FailFast = true
+	limiter := NewTokenBucketLimiter(config)
+	ctx := context.Background()
+
+	for i := range 5 {
+		if err := limiter.Wait(ctx, "users"); err != nil {
+			t.Fatalf("request %d: Wait() error = %v", i, err)
+		}
+	}
+	if err := limiter.Wait(ctx, "users"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Wait() after burst error = %v, want ErrRateLimited", err)
+	}
+	if wait := limiter.WaitTime("users"); wait < 59*time.Second || wait > time.Minute {
+		t.Errorf("WaitTime() = %v, want about 1m", wait)
+	}
+}
+
+func TestTokenBucketLimiterGroups(t *testing.T) {
+	limiter := NewTokenBucketLimiter(TokenBucketConfig{
+		Groups:   map[string]Limit{"auth": {Every: time.Hour, Burst: 1}},
+		FailFast: true,
+	})
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx, "auth"); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if err := limiter.Wait(ctx, "auth"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Wait(auth) error = %v, want ErrRateLimited", err)
+	}
+	// Groups without a limit are never limited
+	for range 10 {
+		if err := limiter.Wait(ctx, "worlds"); err != nil {
+			t.Fatalf("Wait(worlds) error = %v", err)
+		}
+	}
+	if wait := limiter.WaitTime("worlds"); wait != 0 {
+		t.Errorf("WaitTime(worlds) = %v, want 0", wait)
+	}
+}
+
+func TestTokenBucketLimiterFailFast(t *testing.T) {
+	limiter := NewTokenBucketLimiter(TokenBucketConfig{
+		Default:  Limit{Every: time.Hour, Burst: 1},
+		FailFast: true,
+	})
+	ctx := context.Background()
+
+	if wait := limiter.WaitTime("users"); wait != 0 {
+		t.Errorf("WaitTime() before the first request = %v, want 0", wait)
+	}
+	if err := limiter.Wait(ctx, "users"); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+	if err := limiter.Wait(ctx, "users"); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("Wait() error = %v, want ErrRateLimited", err)
+	}
+	if wait := limiter.WaitTime("users"); wait <= 59*time.Minute {
+		t.Errorf("WaitTime() = %v, want about 1h", wait)
+	}
+}
+
+func TestTokenBucketLimiterCancel(t *testing.T) {
+	limiter := NewTokenBucketLimiter(TokenBucketConfig{Default: Limit{Every: time.Hour, Burst: 1}})
+	if err := limiter.Wait(context.Background(), "users"); err != nil {
+		t.Fatalf("Wait() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx, "users"); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() error = %v, want context.DeadlineExceeded", err)
+	}
+	// The token reserved by the cancelled request is returned
+	if wait := limiter.WaitTime("users"); wait > time.Hour {
+		t.Errorf("WaitTime() = %v, want at most 1h", wait)
+	}
+}
+
+func TestRateLimiterClient(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	limiter := NewTokenBucketLimiter(TokenBucketConfig{
+		Groups:   map[string]Limit{"health": {Every: time.Hour, Burst: 1}},
+		FailFast: true,
+	})
+	client := NewClient(srv.URL, WithRateLimiter(limiter))
+
+	if _, err := client.GetHealth(); err != nil {
+		t.Fatalf("first request error = %v", err)
+	}
+	if _, err := client.GetHealth(); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("second request error = %v, want ErrRateLimited", err)
+	}
+	if requests != 1 {
+		t.Errorf("server received %d requests, want 1", requests)
+	}
+}
+
+func TestEndpointGroup(t *testing.T) {
+	tests := map[string]string{
+		"/users/usr_xxx/friends": "users",
+		"/auth/user":             "auth",
+		"https://api.vrchat.cloud/api/1/worlds?n=10": "worlds",
+		"health": "health",
+	}
+	for path, want := range tests {
+		if got := EndpointGroup(path); got != want {
+			t.Errorf("EndpointGroup(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// CredentialsProvider provides the credentials to log in again when the
+// session expires.
+type CredentialsProvider interface {
+	// Credentials returns the username, the password and, for accounts with
+	// two-factor authentication, the provider of second factor codes.
+	Credentials(ctx context.Context) (username, password string, codes CodeProvider, err error)
+}
+
+// StaticCredentials is a CredentialsProvider with fixed credentials.
+type StaticCredentials struct {
+	Username string
+	Password string
+	Codes    CodeProvider
+}
+
+// Credentials implements CredentialsProvider.
+func (s StaticCredentials) Credentials(ctx context.Context) (string, string, CodeProvider, error) {
+	return s.Username, s.Password, s.Codes, nil
+}
+
+// WithReauth makes the client log in again with the credentials from p when
+// a request fails because the session expired, and replay the request once.
+// Concurrent requests wait for a single login.
+func WithReauth(p CredentialsProvider) ClientOption {
+	return func(o *clientOptions) {
+		o.credentials = p
+	}
+}
+
+// reauthContextKey marks the requests of a re-login, which must not trigger
+// another one.
+type reauthContextKey struct{}
+
+// reauthTransport is an http.RoundTripper that logs in again on 401 Missing
+// Credentials responses and replays the request.
+type reauthTransport struct {
+	base        http.RoundTripper
+	client      *Client
+	credentials CredentialsProvider
+
+	mu sync.Mutex
+}
+
+func (t *reauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(reauthContextKey{}) != nil {
+		return t.base.RoundTrip(req)
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	// Only an expired or missing session is fixed by logging in again. The
+	// error is near the start of the body, the rest is passed on unread.
+	prefix, err := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+	resp.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), resp.Body), resp.Body}
+	if !bytes.Contains(prefix, []byte("Missing Credentials")) {
+		return resp, nil
+	}
+	if req.Body != nil && req.GetBody == nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	var token string
+	if cookie, err := req.Cookie(authCookieName); err == nil {
+		token = cookie.Value
+	}
+	if err := t.reauth(req.Context(), token); err != nil {
+		return nil, fmt.Errorf("re-authentication failed: %w", err)
+	}
+	return t.base.RoundTrip(t.replay(req))
+}
+
+// reauth logs in again unless the session no longer uses the auth token the
+// failed request was sent with, i.e. another request already logged in again.
+func (t *reauthTransport) reauth(ctx context.Context, token string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if s := t.client.Session(); s != nil && s.AuthToken != token {
+		return nil
+	}
+
+	username, password, codes, err := t.credentials.Credentials(ctx)
+	if err != nil {
+		return err
+	}
+	ctx = context.WithValue(ctx, reauthContextKey{}, true)
+	_, err = t.client.LoginWithCodeProvider(ctx, username, password, codes)
+	return err
+}
+
+// replay returns a copy of req with a fresh body and the new session cookies.
+func (t *reauthTransport) replay(req *http.Request) *http.Request {
+	r := req.Clone(req.Context())
+	if req.GetBody != nil {
+		// GetBody never fails for the in-memory bodies the client sends
+		r.Body, _ = req.GetBody()
+	}
+	r.Header.Del("Cookie")
+	if jar := t.client.client.GetClient().Jar; jar != nil {
+		for _, cookie := range jar.Cookies(req.URL) {
+			r.AddCookie(cookie)
+		}
+	}
+	return r
+}
@@ -0,0 +1,106 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestReauthConcurrent(t *testing.T) {
+	server, _ := newAuthServer(t)
+	var mu sync.Mutex
+	var bodies []string
+	server.api = func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			data, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			bodies = append(bodies, string(data))
+			mu.Unlock()
+		}
+		writeJSON(w, map[string]any{})
+	}
+	client := NewClient(server.url, WithReauth(StaticCredentials{Username: "user", Password: "password"}))
+	ctx := context.Background()
+
+	if _, err := client.LoginWithCodeProvider(ctx, "user", "password", nil); err != nil {
+		t.Fatalf("LoginWithCodeProvider() error = %v", err)
+	}
+	server.expire()
+
+	const n = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, n)
+	for i := range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var err error
+			if i%2 == 0 {
+				_, err = client.GetConfigWithContext(ctx)
+			} else {
+				_, err = client.CreateGroupWithContext(ctx, CreateGroupRequest{Name: "Test Group", ShortCode: "TEST", RoleTemplate: GroupRoleTemplateDefault})
+			}
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Errorf("request error = %v, want replayed success", err)
+		}
+	}
+
+	server.mu.Lock()
+	logins := server.sessions
+	server.mu.Unlock()
+	if logins != 2 {
+		t.Errorf("logged in %d times after the session expired, want 1", logins-1)
+	}
+	if len(bodies) != n/2 {
+		t.Fatalf("got %d POST bodies, want %d", len(bodies), n/2)
+	}
+	for _, body := range bodies {
+		var req CreateGroupRequest
+		if err := json.Unmarshal([]byte(body), &req); err != nil || req.Name != "Test Group" {
+			t.Errorf("replayed body = %q, want the group request", body)
+		}
+	}
+}
+
+func TestReauthLoginFails(t *testing.T) {
+	server, _ := newAuthServer(t)
+	client := NewClient(server.url, WithReauth(StaticCredentials{Username: "user", Password: "wrong"}))
+
+	_, err := client.GetConfigWithContext(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "re-authentication failed") || !IsUnauthorized(err) {
+		t.Errorf("GetConfig() error = %v, want failed re-authentication", err)
+	}
+	if n := server.count("GET /config"); n != 1 {
+		t.Errorf("GET /config sent %d times, want 1", n)
+	}
+}
+
+func TestReauthKeepsBody(t *testing.T) {
+	// A 401 other than Missing Credentials is returned with its whole body
+	message := strings.Repeat("x", 100<<10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusUnauthorized, message)
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(srv.URL, WithReauth(StaticCredentials{Username: "user", Password: "password"}))
+
+	_, err := client.GetConfigWithContext(context.Background())
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("GetConfig() error = %v, want *APIError", err)
+	}
+	if apiErr.Message != message {
+		t.Errorf("message has %d bytes, want %d", len(apiErr.Message), len(message))
+	}
+}
@@ -0,0 +1,113 @@
+package vrchat
+
+import (
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy configures automatic retries of failed requests.
+//
+// Waits between attempts grow exponentially from MinWait up to MaxWait with
+// random jitter. When the server sends a Retry-After header, its value is used
+// instead, capped at MaxWait.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt.
+	MaxRetries int
+	// MinWait is the wait before the first retry.
+	MinWait time.Duration
+	// MaxWait is the upper bound of the wait between attempts.
+	MaxWait time.Duration
+	// StatusCodes are the response status codes that trigger a retry.
+	StatusCodes []int
+	// RetryNonIdempotent enables retries of POST, PUT and PATCH requests.
+	// By default only GET, HEAD, OPTIONS and DELETE requests are retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a policy that retries idempotent requests up to
+// three times on rate limiting and transient server errors.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: 3,
+		MinWait:    500 * time.Millisecond,
+		MaxWait:    30 * time.Second,
+		StatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// WithRetryPolicy enables automatic retries according to p.
+func WithRetryPolicy(p RetryPolicy) ClientOption {
+	return func(o *clientOptions) {
+		o.retryPolicy = &p
+	}
+}
+
+func (p RetryPolicy) apply(rc *resty.Client) {
+	rc.SetRetryCount(p.MaxRetries).
+		SetRetryWaitTime(p.MinWait).
+		SetRetryMaxWaitTime(p.MaxWait).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(p.shouldRetry)
+}
+
+func (p RetryPolicy) shouldRetry(resp *resty.Response, err error) bool {
+	if resp == nil || resp.Request == nil || !p.retryable(resp.Request.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	return slices.Contains(p.StatusCodes, resp.StatusCode())
+}
+
+func (p RetryPolicy) retryable(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		return p.RetryNonIdempotent
+	}
+	return false
+}
+
+// backoff returns the wait before the retry following attempt, which grows
+// exponentially from MinWait up to MaxWait with random jitter.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.MaxWait
+	if attempt < 32 && p.MinWait<<attempt < p.MaxWait {
+		wait = p.MinWait << attempt
+	}
+	if wait <= 0 {
+		return 0
+	}
+	return wait/2 + rand.N(wait/2+1)
+}
+
+// retryAfter returns the wait requested by the Retry-After header, or 0 to
+// fall back to exponential backoff.
+func retryAfter(_ *resty.Client, resp *resty.Response) (time.Duration, error) {
+	if resp == nil || resp.RawResponse == nil {
+		return 0, nil
+	}
+	value := resp.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), nil
+	}
+	return 0, nil
+}
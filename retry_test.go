@@ -0,0 +1,109 @@
+package vrchat
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestServer(t *testing.T, failures int32, status int, header http.Header) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if hits.Add(1) <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"ok":true,"serverName":"test","buildVersionTag":"1"}`))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func testRetryPolicy() RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.MinWait = time.Millisecond
+	p.MaxWait = 10 * time.Millisecond
+	return p
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	srv, hits := newRetryTestServer(t, 2, http.StatusServiceUnavailable, nil)
+	c := NewClient(srv.URL, WithRetryPolicy(testRetryPolicy()))
+
+	health, err := c.GetHealth()
+	if err != nil {
+		t.Fatalf("GetHealth: %v", err)
+	}
+	if !health.Ok {
+		t.Errorf("health.Ok = false, want true")
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("server hit %d times, want 3", got)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	srv, hits := newRetryTestServer(t, 10, http.StatusBadGateway, nil)
+	p := testRetryPolicy()
+	p.MaxRetries = 2
+	c := NewClient(srv.URL, WithRetryPolicy(p))
+
+	_, err := c.GetHealth()
+	if StatusCode(err) != http.StatusBadGateway {
+		t.Fatalf("GetHealth error = %v, want status 502", err)
+	}
+	if got := hits.Load(); got != 3 {
+		t.Errorf("server hit %d times, want 3", got)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	srv, hits := newRetryTestServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": {"1"}})
+	p := testRetryPolicy()
+	p.MaxWait = 5 * time.Second
+	c := NewClient(srv.URL, WithRetryPolicy(p))
+
+	start := time.Now()
+	if _, err := c.GetHealth(); err != nil {
+		t.Fatalf("GetHealth: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least 1s", elapsed)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hit %d times, want 2", got)
+	}
+}
+
+func TestRetrySkipsNonIdempotent(t *testing.T) {
+	srv, hits := newRetryTestServer(t, 1, http.StatusServiceUnavailable, nil)
+	c := NewClient(srv.URL, WithRetryPolicy(testRetryPolicy()))
+
+	if _, err := c.Friend(FriendParams{UserId: "usr_test"}); err == nil {
+		t.Fatal("Friend succeeded, want error")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Errorf("server hit %d times, want 1", got)
+	}
+}
+
+func TestRetryNonIdempotentOptIn(t *testing.T) {
+	srv, hits := newRetryTestServer(t, 1, http.StatusServiceUnavailable, nil)
+	p := testRetryPolicy()
+	p.RetryNonIdempotent = true
+	c := NewClient(srv.URL, WithRetryPolicy(p))
+
+	if _, err := c.Friend(FriendParams{UserId: "usr_test"}); err != nil {
+		t.Fatalf("Friend: %v", err)
+	}
+	if got := hits.Load(); got != 2 {
+		t.Errorf("server hit %d times, want 2", got)
+	}
+}
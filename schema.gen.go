@@ -375,33 +375,33 @@ const (
 )
 
 type CreateAvatarRequest struct {
-	AssetUrl      string        `json:"assetUrl,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	Id            AvatarId      `json:"id,omitempty"`
-	ImageUrl      string        `json:"imageUrl"`
-	Name          string        `json:"name"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	AssetUrl      *string        `json:"assetUrl,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	Id            *AvatarId      `json:"id,omitempty"`
+	ImageUrl      string         `json:"imageUrl"`
+	Name          string         `json:"name"`
+	ReleaseStatus *ReleaseStatus `json:"releaseStatus,omitempty"`
 
 	// Tags
-	Tags            []Tag   `json:"tags,omitempty"`
-	UnityPackageUrl string  `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string  `json:"unityVersion,omitempty"`
-	Version         float64 `json:"version,omitempty"`
+	Tags            *[]Tag   `json:"tags,omitempty"`
+	UnityPackageUrl *string  `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string  `json:"unityVersion,omitempty"`
+	Version         *float64 `json:"version,omitempty"`
 }
 
 type UpdateAvatarRequest struct {
-	AssetUrl      string        `json:"assetUrl,omitempty"`
-	Description   string        `json:"description,omitempty"`
-	Id            AvatarId      `json:"id,omitempty"`
-	ImageUrl      string        `json:"imageUrl,omitempty"`
-	Name          string        `json:"name,omitempty"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	AssetUrl      *string        `json:"assetUrl,omitempty"`
+	Description   *string        `json:"description,omitempty"`
+	Id            *AvatarId      `json:"id,omitempty"`
+	ImageUrl      *string        `json:"imageUrl,omitempty"`
+	Name          *string        `json:"name,omitempty"`
+	ReleaseStatus *ReleaseStatus `json:"releaseStatus,omitempty"`
 
 	// Tags
-	Tags            []Tag   `json:"tags,omitempty"`
-	UnityPackageUrl string  `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string  `json:"unityVersion,omitempty"`
-	Version         float64 `json:"version,omitempty"`
+	Tags            *[]Tag   `json:"tags,omitempty"`
+	UnityPackageUrl *string  `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string  `json:"unityVersion,omitempty"`
+	Version         *float64 `json:"version,omitempty"`
 }
 
 type TransactionId string
@@ -613,11 +613,11 @@ type FavoriteGroup struct {
 }
 
 type UpdateFavoriteGroupRequest struct {
-	DisplayName string `json:"displayName,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
 
 	// Tags Tags on FavoriteGroups are believed to do nothing.
-	Tags       []Tag                   `json:"tags,omitempty"`
-	Visibility FavoriteGroupVisibility `json:"visibility,omitempty"`
+	Tags       *[]Tag                   `json:"tags,omitempty"`
+	Visibility *FavoriteGroupVisibility `json:"visibility,omitempty"`
 }
 
 type FileId string
@@ -696,19 +696,19 @@ type CreateFileRequest struct {
 	Name      string   `json:"name"`
 
 	// Tags
-	Tags []Tag `json:"tags,omitempty"`
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
 type CreateFileVersionRequest struct {
-	FileMd5              string  `json:"fileMd5,omitempty"`
-	FileSizeInBytes      float64 `json:"fileSizeInBytes,omitempty"`
-	SignatureMd5         string  `json:"signatureMd5"`
-	SignatureSizeInBytes float64 `json:"signatureSizeInBytes"`
+	FileMd5              *string  `json:"fileMd5,omitempty"`
+	FileSizeInBytes      *float64 `json:"fileSizeInBytes,omitempty"`
+	SignatureMd5         string   `json:"signatureMd5"`
+	SignatureSizeInBytes float64  `json:"signatureSizeInBytes"`
 }
 
 type FinishFileDataUploadRequest struct {
 	// Etags Array of ETags uploaded.
-	Etags []string `json:"etags,omitempty"`
+	Etags *[]string `json:"etags,omitempty"`
 
 	// MaxParts Always a zero in string form, despite how many parts uploaded.
 	MaxParts string `json:"maxParts"`
@@ -915,12 +915,12 @@ const (
 )
 
 type CreateGroupRequest struct {
-	BannerId     string            `json:"bannerId,omitempty"`
-	Description  string            `json:"description,omitempty"`
-	IconId       string            `json:"iconId,omitempty"`
-	JoinState    GroupJoinState    `json:"joinState,omitempty"`
+	BannerId     *string           `json:"bannerId,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	IconId       *string           `json:"iconId,omitempty"`
+	JoinState    *GroupJoinState   `json:"joinState,omitempty"`
 	Name         string            `json:"name"`
-	Privacy      GroupPrivacy      `json:"privacy,omitempty"`
+	Privacy      *GroupPrivacy     `json:"privacy,omitempty"`
 	RoleTemplate GroupRoleTemplate `json:"roleTemplate"`
 	ShortCode    string            `json:"shortCode"`
 }
@@ -1006,20 +1006,20 @@ type Group struct {
 }
 
 type UpdateGroupRequest struct {
-	BannerId    string         `json:"bannerId,omitempty"`
-	Description string         `json:"description,omitempty"`
-	IconId      string         `json:"iconId,omitempty"`
-	JoinState   GroupJoinState `json:"joinState,omitempty"`
+	BannerId    *string         `json:"bannerId,omitempty"`
+	Description *string         `json:"description,omitempty"`
+	IconId      *string         `json:"iconId,omitempty"`
+	JoinState   *GroupJoinState `json:"joinState,omitempty"`
 
 	// Languages 3 letter language code
-	Languages []string `json:"languages,omitempty"`
-	Links     []string `json:"links,omitempty"`
-	Name      string   `json:"name,omitempty"`
-	Rules     string   `json:"rules,omitempty"`
-	ShortCode string   `json:"shortCode,omitempty"`
+	Languages *[]string `json:"languages,omitempty"`
+	Links     *[]string `json:"links,omitempty"`
+	Name      *string   `json:"name,omitempty"`
+	Rules     *string   `json:"rules,omitempty"`
+	ShortCode *string   `json:"shortCode,omitempty"`
 
 	// Tags
-	Tags []Tag `json:"tags,omitempty"`
+	Tags *[]Tag `json:"tags,omitempty"`
 }
 
 type GroupAnnouncementId string
@@ -1038,13 +1038,13 @@ type GroupAnnouncement struct {
 }
 
 type CreateGroupAnnouncementRequest struct {
-	ImageId FileId `json:"imageId,omitempty"`
+	ImageId *FileId `json:"imageId,omitempty"`
 
 	// SendNotification Send notification to group members.
-	SendNotification bool `json:"sendNotification,omitempty"`
+	SendNotification *bool `json:"sendNotification,omitempty"`
 
 	// Text Announcement text
-	Text string `json:"text,omitempty"`
+	Text *string `json:"text,omitempty"`
 
 	// Title Announcement title
 	Title string `json:"title"`
@@ -1135,25 +1135,25 @@ type BanGroupMemberRequest struct {
 
 type CreateGroupGalleryRequest struct {
 	// Description Description of the gallery.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// MembersOnly Whether the gallery is members only.
-	MembersOnly bool `json:"membersOnly,omitempty"`
+	MembersOnly *bool `json:"membersOnly,omitempty"`
 
 	// Name Name of the gallery.
 	Name string `json:"name"`
 
 	// RoleIdsToAutoApprove
-	RoleIdsToAutoApprove []GroupRoleId `json:"roleIdsToAutoApprove,omitempty"`
+	RoleIdsToAutoApprove *[]GroupRoleId `json:"roleIdsToAutoApprove,omitempty"`
 
 	// RoleIdsToManage
-	RoleIdsToManage []GroupRoleId `json:"roleIdsToManage,omitempty"`
+	RoleIdsToManage *[]GroupRoleId `json:"roleIdsToManage,omitempty"`
 
 	// RoleIdsToSubmit
-	RoleIdsToSubmit []GroupRoleId `json:"roleIdsToSubmit,omitempty"`
+	RoleIdsToSubmit *[]GroupRoleId `json:"roleIdsToSubmit,omitempty"`
 
 	// RoleIdsToView
-	RoleIdsToView []GroupRoleId `json:"roleIdsToView,omitempty"`
+	RoleIdsToView *[]GroupRoleId `json:"roleIdsToView,omitempty"`
 }
 
 type GroupGalleryImageId string
@@ -1177,25 +1177,25 @@ type GroupGalleryImage struct {
 
 type UpdateGroupGalleryRequest struct {
 	// Description Description of the gallery.
-	Description string `json:"description,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// MembersOnly Whether the gallery is members only.
-	MembersOnly bool `json:"membersOnly,omitempty"`
+	MembersOnly *bool `json:"membersOnly,omitempty"`
 
 	// Name Name of the gallery.
-	Name string `json:"name,omitempty"`
+	Name *string `json:"name,omitempty"`
 
 	// RoleIdsToAutoApprove
-	RoleIdsToAutoApprove []GroupRoleId `json:"roleIdsToAutoApprove,omitempty"`
+	RoleIdsToAutoApprove *[]GroupRoleId `json:"roleIdsToAutoApprove,omitempty"`
 
 	// RoleIdsToManage
-	RoleIdsToManage []GroupRoleId `json:"roleIdsToManage,omitempty"`
+	RoleIdsToManage *[]GroupRoleId `json:"roleIdsToManage,omitempty"`
 
 	// RoleIdsToSubmit
-	RoleIdsToSubmit []GroupRoleId `json:"roleIdsToSubmit,omitempty"`
+	RoleIdsToSubmit *[]GroupRoleId `json:"roleIdsToSubmit,omitempty"`
 
 	// RoleIdsToView
-	RoleIdsToView []GroupRoleId `json:"roleIdsToView,omitempty"`
+	RoleIdsToView *[]GroupRoleId `json:"roleIdsToView,omitempty"`
 }
 
 type AddGroupGalleryImageRequest struct {
@@ -1266,7 +1266,7 @@ type GroupInstance struct {
 }
 
 type CreateGroupInviteRequest struct {
-	ConfirmOverrideBlock bool `json:"confirmOverrideBlock,omitempty"`
+	ConfirmOverrideBlock *bool `json:"confirmOverrideBlock,omitempty"`
 
 	// UserId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
 	UserId UserId `json:"userId"`
@@ -1315,9 +1315,9 @@ const (
 )
 
 type UpdateGroupMemberRequest struct {
-	IsSubscribedToAnnouncements bool                `json:"isSubscribedToAnnouncements,omitempty"`
-	ManagerNotes                string              `json:"managerNotes,omitempty"`
-	Visibility                  GroupUserVisibility `json:"visibility,omitempty"`
+	IsSubscribedToAnnouncements *bool                `json:"isSubscribedToAnnouncements,omitempty"`
+	ManagerNotes                *string              `json:"managerNotes,omitempty"`
+	Visibility                  *GroupUserVisibility `json:"visibility,omitempty"`
 }
 
 // GroupRoleIdList
@@ -1371,10 +1371,10 @@ type GroupPost struct {
 }
 
 type CreateGroupPostRequest struct {
-	ImageId FileId `json:"imageId,omitempty"`
+	ImageId *FileId `json:"imageId,omitempty"`
 
 	// RoleIds
-	RoleIds GroupRoleIdList `json:"roleIds,omitempty"`
+	RoleIds *GroupRoleIdList `json:"roleIds,omitempty"`
 
 	// SendNotification Send notification to group members.
 	SendNotification bool `json:"sendNotification"`
@@ -1398,29 +1398,29 @@ type RespondGroupJoinRequest struct {
 	Action GroupJoinRequestAction `json:"action"`
 
 	// Block Whether to block the user from requesting again
-	Block bool `json:"block,omitempty"`
+	Block *bool `json:"block,omitempty"`
 }
 
 type CreateGroupRoleRequest struct {
-	Description      string   `json:"description,omitempty"`
-	Id               string   `json:"id,omitempty"`
-	IsSelfAssignable bool     `json:"isSelfAssignable,omitempty"`
-	Name             string   `json:"name,omitempty"`
-	Permissions      []string `json:"permissions,omitempty"`
+	Description      *string   `json:"description,omitempty"`
+	Id               *string   `json:"id,omitempty"`
+	IsSelfAssignable *bool     `json:"isSelfAssignable,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	Permissions      *[]string `json:"permissions,omitempty"`
 }
 
 type UpdateGroupRoleRequest struct {
-	Description      string   `json:"description,omitempty"`
-	IsSelfAssignable bool     `json:"isSelfAssignable,omitempty"`
-	Name             string   `json:"name,omitempty"`
-	Order            int64    `json:"order,omitempty"`
-	Permissions      []string `json:"permissions,omitempty"`
+	Description      *string   `json:"description,omitempty"`
+	IsSelfAssignable *bool     `json:"isSelfAssignable,omitempty"`
+	Name             *string   `json:"name,omitempty"`
+	Order            *int64    `json:"order,omitempty"`
+	Permissions      *[]string `json:"permissions,omitempty"`
 }
 
 type InviteRequest struct {
 	// InstanceId InstanceID can be "offline" on User profiles if you are not friends with that user and "private" if you are friends and user is in private instance.
 	InstanceId  InstanceId `json:"instanceId"`
-	MessageSlot int64      `json:"messageSlot,omitempty"`
+	MessageSlot *int64     `json:"messageSlot,omitempty"`
 }
 
 type SentNotification struct {
@@ -1441,7 +1441,7 @@ type SentNotification struct {
 }
 
 type RequestInviteRequest struct {
-	MessageSlot int64 `json:"messageSlot,omitempty"`
+	MessageSlot *int64 `json:"messageSlot,omitempty"`
 }
 
 type InviteResponse struct {
@@ -1510,28 +1510,28 @@ const (
 
 type CreateInstanceRequest struct {
 	// CanRequestInvite Only applies to invite type instances to make them invite+
-	CanRequestInvite bool `json:"canRequestInvite,omitempty"`
+	CanRequestInvite *bool `json:"canRequestInvite,omitempty"`
 
 	// ClosedAt The time after which users won't be allowed to join the instance. This doesn't work for public instances.
-	ClosedAt time.Time `json:"closedAt,omitempty"`
+	ClosedAt *time.Time `json:"closedAt,omitempty"`
 
 	// GroupAccessType Group access type when the instance type is "group"
-	GroupAccessType GroupAccessType `json:"groupAccessType,omitempty"`
+	GroupAccessType *GroupAccessType `json:"groupAccessType,omitempty"`
 
 	// HardClose Currently unused, but will eventually be a flag to set if the closing of the instance should kick people.
-	HardClose  bool `json:"hardClose,omitempty"`
-	InviteOnly bool `json:"inviteOnly,omitempty"`
+	HardClose  *bool `json:"hardClose,omitempty"`
+	InviteOnly *bool `json:"inviteOnly,omitempty"`
 
 	// OwnerId A groupId if the instance type is "group", null if instance type is public, or a userId otherwise
-	OwnerId      InstanceOwnerId `json:"ownerId,omitempty"`
-	QueueEnabled bool            `json:"queueEnabled,omitempty"`
+	OwnerId      *InstanceOwnerId `json:"ownerId,omitempty"`
+	QueueEnabled *bool            `json:"queueEnabled,omitempty"`
 
 	// Region Instance region
 	Region InstanceRegion `json:"region"`
 
 	// RoleIds Group roleIds that are allowed to join if the type is "group" and groupAccessType is "member"
-	RoleIds []GroupRoleId `json:"roleIds,omitempty"`
-	Type    InstanceType  `json:"type"`
+	RoleIds *[]GroupRoleId `json:"roleIds,omitempty"`
+	Type    InstanceType   `json:"type"`
 
 	// WorldId WorldID be "offline" on User profiles if you are not friends with that user.
 	WorldId WorldId `json:"worldId"`
@@ -2152,23 +2152,23 @@ type User struct {
 }
 
 type UpdateUserRequest struct {
-	AcceptedTosVersion float64  `json:"acceptedTOSVersion,omitempty"`
-	Bio                string   `json:"bio,omitempty"`
-	BioLinks           []string `json:"bioLinks,omitempty"`
-	Birthday           string   `json:"birthday,omitempty"`
-	Email              string   `json:"email,omitempty"`
-	IsBoopingEnabled   bool     `json:"isBoopingEnabled,omitempty"`
-	Pronouns           string   `json:"pronouns,omitempty"`
+	AcceptedTosVersion *float64  `json:"acceptedTOSVersion,omitempty"`
+	Bio                *string   `json:"bio,omitempty"`
+	BioLinks           *[]string `json:"bioLinks,omitempty"`
+	Birthday           *string   `json:"birthday,omitempty"`
+	Email              *string   `json:"email,omitempty"`
+	IsBoopingEnabled   *bool     `json:"isBoopingEnabled,omitempty"`
+	Pronouns           *string   `json:"pronouns,omitempty"`
 
 	// Status Defines the User's current status, for example "ask me", "join me" or "offline. This status is a combined indicator of their online activity and privacy preference.
-	Status            UserStatus `json:"status,omitempty"`
-	StatusDescription string     `json:"statusDescription,omitempty"`
+	Status            *UserStatus `json:"status,omitempty"`
+	StatusDescription *string     `json:"statusDescription,omitempty"`
 
 	// Tags
-	Tags []Tag `json:"tags,omitempty"`
+	Tags *[]Tag `json:"tags,omitempty"`
 
 	// UserIcon MUST be a valid VRChat /file/ url.
-	UserIcon string `json:"userIcon,omitempty"`
+	UserIcon *string `json:"userIcon,omitempty"`
 }
 
 type LimitedUserGroups struct {
@@ -2254,49 +2254,49 @@ type LimitedWorld struct {
 
 type CreateWorldRequest struct {
 	AssetUrl     string `json:"assetUrl"`
-	AssetVersion int64  `json:"assetVersion,omitempty"`
+	AssetVersion *int64 `json:"assetVersion,omitempty"`
 
 	// AuthorId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	AuthorId    UserId `json:"authorId,omitempty"`
-	AuthorName  string `json:"authorName,omitempty"`
-	Capacity    int64  `json:"capacity,omitempty"`
-	Description string `json:"description,omitempty"`
+	AuthorId    *UserId `json:"authorId,omitempty"`
+	AuthorName  *string `json:"authorName,omitempty"`
+	Capacity    *int64  `json:"capacity,omitempty"`
+	Description *string `json:"description,omitempty"`
 
 	// Id WorldID be "offline" on User profiles if you are not friends with that user.
-	Id       WorldId `json:"id,omitempty"`
-	ImageUrl string  `json:"imageUrl"`
-	Name     string  `json:"name"`
+	Id       *WorldId `json:"id,omitempty"`
+	ImageUrl string   `json:"imageUrl"`
+	Name     string   `json:"name"`
 
 	// Platform This can be `standalonewindows` or `android`, but can also pretty much be any random Unity verison such as `2019.2.4-801-Release` or `2019.2.2-772-Release` or even `unknownplatform`.
-	Platform      Platform      `json:"platform,omitempty"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	Platform      *Platform      `json:"platform,omitempty"`
+	ReleaseStatus *ReleaseStatus `json:"releaseStatus,omitempty"`
 
 	// Tags
-	Tags            []Tag  `json:"tags,omitempty"`
-	UnityPackageUrl string `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string `json:"unityVersion,omitempty"`
+	Tags            *[]Tag  `json:"tags,omitempty"`
+	UnityPackageUrl *string `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string `json:"unityVersion,omitempty"`
 }
 
 type UpdateWorldRequest struct {
-	AssetUrl     string `json:"assetUrl,omitempty"`
-	AssetVersion string `json:"assetVersion,omitempty"`
+	AssetUrl     *string `json:"assetUrl,omitempty"`
+	AssetVersion *string `json:"assetVersion,omitempty"`
 
 	// AuthorId A users unique ID, usually in the form of `usr_c1644b5b-3ca4-45b4-97c6-a2a0de70d469`. Legacy players can have old IDs in the form of `8JoV9XEdpo`. The ID can never be changed.
-	AuthorId    UserId `json:"authorId,omitempty"`
-	AuthorName  string `json:"authorName,omitempty"`
-	Capacity    int64  `json:"capacity,omitempty"`
-	Description string `json:"description,omitempty"`
-	ImageUrl    string `json:"imageUrl,omitempty"`
-	Name        string `json:"name,omitempty"`
+	AuthorId    *UserId `json:"authorId,omitempty"`
+	AuthorName  *string `json:"authorName,omitempty"`
+	Capacity    *int64  `json:"capacity,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ImageUrl    *string `json:"imageUrl,omitempty"`
+	Name        *string `json:"name,omitempty"`
 
 	// Platform This can be `standalonewindows` or `android`, but can also pretty much be any random Unity verison such as `2019.2.4-801-Release` or `2019.2.2-772-Release` or even `unknownplatform`.
-	Platform      Platform      `json:"platform,omitempty"`
-	ReleaseStatus ReleaseStatus `json:"releaseStatus,omitempty"`
+	Platform      *Platform      `json:"platform,omitempty"`
+	ReleaseStatus *ReleaseStatus `json:"releaseStatus,omitempty"`
 
 	// Tags
-	Tags            []Tag  `json:"tags,omitempty"`
-	UnityPackageUrl string `json:"unityPackageUrl,omitempty"`
-	UnityVersion    string `json:"unityVersion,omitempty"`
+	Tags            *[]Tag  `json:"tags,omitempty"`
+	UnityPackageUrl *string `json:"unityPackageUrl,omitempty"`
+	UnityVersion    *string `json:"unityVersion,omitempty"`
 }
 
 type WorldMetadata struct {
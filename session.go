@@ -0,0 +1,188 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	authCookieName          = "auth"
+	twoFactorAuthCookieName = "twoFactorAuth"
+)
+
+// ErrNoSession is returned by a SessionStore that has no saved session.
+var ErrNoSession = errors.New("no saved session")
+
+// Session holds the cookies of an authenticated session.
+type Session struct {
+	// AuthToken is the value of the auth cookie.
+	AuthToken string `json:"authToken"`
+	// TwoFactorAuthToken is the value of the twoFactorAuth cookie, if any.
+	TwoFactorAuthToken string `json:"twoFactorAuthToken,omitempty"`
+}
+
+// SessionStore saves sessions across restarts.
+type SessionStore interface {
+	// Load returns the saved session, or ErrNoSession if there is none.
+	Load(ctx context.Context) (*Session, error)
+	// Save saves s, replacing any saved session.
+	Save(ctx context.Context, s *Session) error
+}
+
+// MemorySessionStore is a SessionStore that keeps the session in memory.
+type MemorySessionStore struct {
+	mu      sync.Mutex
+	session *Session
+}
+
+// Load implements SessionStore.
+func (m *MemorySessionStore) Load(ctx context.Context) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.session == nil {
+		return nil, ErrNoSession
+	}
+	s := *m.session
+	return &s, nil
+}
+
+// Save implements SessionStore.
+func (m *MemorySessionStore) Save(ctx context.Context, s *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *s
+	m.session = &saved
+	return nil
+}
+
+// FileSessionStore is a SessionStore that keeps the session in a JSON file.
+// The file contains credentials and is only readable by its owner.
+type FileSessionStore struct {
+	Path string
+}
+
+// NewFileSessionStore creates a FileSessionStore that keeps the session at path.
+func NewFileSessionStore(path string) *FileSessionStore {
+	return &FileSessionStore{Path: path}
+}
+
+// Load implements SessionStore.
+func (f *FileSessionStore) Load(ctx context.Context) (*Session, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, ErrNoSession
+	}
+	if err != nil {
+		return nil, err
+	}
+	var s Session
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error decoding session: %w", err)
+	}
+	return &s, nil
+}
+
+// Save implements SessionStore.
+func (f *FileSessionStore) Save(ctx context.Context, s *Session) error {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that a crash never leaves a truncated session behind
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), filepath.Base(f.Path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), f.Path)
+}
+
+// Session returns the session cookies of the client, or nil if it is not logged in.
+func (c *Client) Session() *Session {
+	jar, u := c.client.GetClient().Jar, c.baseURL()
+	if jar == nil || u == nil {
+		return nil
+	}
+	var s Session
+	for _, cookie := range jar.Cookies(u) {
+		switch cookie.Name {
+		case authCookieName:
+			s.AuthToken = cookie.Value
+		case twoFactorAuthCookieName:
+			s.TwoFactorAuthToken = cookie.Value
+		}
+	}
+	if s.AuthToken == "" {
+		return nil
+	}
+	return &s
+}
+
+// SetSession makes the client use the session cookies of s.
+func (c *Client) SetSession(s *Session) {
+	jar, u := c.client.GetClient().Jar, c.baseURL()
+	if jar == nil || u == nil {
+		return
+	}
+	cookies := []*http.Cookie{{Name: authCookieName, Value: s.AuthToken, Path: "/"}}
+	if s.TwoFactorAuthToken != "" {
+		cookies = append(cookies, &http.Cookie{Name: twoFactorAuthCookieName, Value: s.TwoFactorAuthToken, Path: "/"})
+	}
+	jar.SetCookies(u, cookies)
+}
+
+// RestoreSession restores the session saved in store and reports whether it
+// is still valid.
+func (c *Client) RestoreSession(ctx context.Context, store SessionStore) (bool, error) {
+	s, err := store.Load(ctx)
+	if errors.Is(err, ErrNoSession) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	c.SetSession(s)
+
+	result, err := c.VerifyAuthTokenWithContext(ctx)
+	if IsUnauthorized(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return result.Ok, nil
+}
+
+// LoginWithSession restores the session saved in store. If there is none or
+// it has expired, it logs in with LoginWithCodeProvider and saves the new session.
+func (c *Client) LoginWithSession(ctx context.Context, store SessionStore, username, password string, codes CodeProvider) error {
+	ok, err := c.RestoreSession(ctx, store)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	if _, err := c.LoginWithCodeProvider(ctx, username, password, codes); err != nil {
+		return err
+	}
+	s := c.Session()
+	if s == nil {
+		return errors.New("no session cookie after login")
+	}
+	return store.Save(ctx, s)
+}
@@ -0,0 +1,100 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoginWithSessionRestores(t *testing.T) {
+	server, client := newAuthServer(t)
+	ctx := context.Background()
+	store := &MemorySessionStore{}
+	if err := client.LoginWithSession(ctx, store, "user", "password", nil); err != nil {
+		t.Fatalf("LoginWithSession() error = %v", err)
+	}
+	logins := server.count("GET /auth/user")
+
+	// A new client with the saved session does not log in again
+	restored := NewClient(server.url)
+	if err := restored.LoginWithSession(ctx, store, "user", "wrong", nil); err != nil {
+		t.Fatalf("LoginWithSession() error = %v", err)
+	}
+	if n := server.count("GET /auth/user"); n != logins {
+		t.Errorf("logged in %d more times, want 0", n-logins)
+	}
+	if s := restored.Session(); s == nil || s.AuthToken != "authcookie_1" {
+		t.Errorf("Session() = %+v, want authcookie_1", s)
+	}
+}
+
+func TestLoginWithSessionExpired(t *testing.T) {
+	server, client := newAuthServer(t)
+	ctx := context.Background()
+	store := &MemorySessionStore{}
+	store.Save(ctx, &Session{AuthToken: "authcookie_0"})
+
+	ok, err := client.RestoreSession(ctx, store)
+	if ok || err != nil {
+		t.Fatalf("RestoreSession() = %v, %v, want false, nil", ok, err)
+	}
+	if err := client.LoginWithSession(ctx, store, "user", "password", nil); err != nil {
+		t.Fatalf("LoginWithSession() error = %v", err)
+	}
+	if s, _ := store.Load(ctx); s == nil || s.AuthToken != "authcookie_1" {
+		t.Errorf("saved session = %+v, want authcookie_1", s)
+	}
+
+	// The saved session expires and is replaced by a new login
+	server.expire()
+	if err := client.LoginWithSession(ctx, store, "user", "password", nil); err != nil {
+		t.Fatalf("LoginWithSession() error = %v", err)
+	}
+	if s, _ := store.Load(ctx); s == nil || s.AuthToken != "authcookie_2" {
+		t.Errorf("saved session = %+v, want authcookie_2", s)
+	}
+}
+
+func TestFileSessionStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+
+	if _, err := store.Load(ctx); !errors.Is(err, ErrNoSession) {
+		t.Fatalf("Load() of missing file error = %v, want ErrNoSession", err)
+	}
+
+	want := Session{AuthToken: "authcookie_1", TwoFactorAuthToken: "2fa_authcookie_1"}
+	if err := store.Save(ctx, &want); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+	got, err := store.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if *got != want {
+		t.Errorf("Load() = %+v, want %+v", *got, want)
+	}
+
+	info, err := os.Stat(store.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file mode = %o, want 600", perm)
+	}
+}
+
+func TestLoginWithSessionMissingFile(t *testing.T) {
+	_, client := newAuthServer(t)
+	ctx := context.Background()
+	store := NewFileSessionStore(filepath.Join(t.TempDir(), "session.json"))
+
+	if err := client.LoginWithSession(ctx, store, "user", "password", nil); err != nil {
+		t.Fatalf("LoginWithSession() error = %v", err)
+	}
+	if s, err := store.Load(ctx); err != nil || s.AuthToken != "authcookie_1" {
+		t.Errorf("saved session = %+v, %v, want authcookie_1", s, err)
+	}
+}
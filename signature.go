@@ -0,0 +1,123 @@
+package vrchat
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/md4"
+)
+
+// SignatureFormat is the magic number of a librsync signature, which
+// identifies the rolling checksum and the strong hash of its blocks.
+type SignatureFormat uint32
+
+// Signature formats using the rollsum rolling checksum.
+const (
+	SignatureFormatMD4    SignatureFormat = 0x72730136
+	SignatureFormatBLAKE2 SignatureFormat = 0x72730137
+)
+
+// DefaultSignatureBlockLen is the block length used by rdiff and the VRChat SDK.
+const DefaultSignatureBlockLen = 2048
+
+// rollsumCharOffset is added to every byte by the rollsum checksum.
+const rollsumCharOffset = 31
+
+// SignatureOptions configures a librsync signature.
+type SignatureOptions struct {
+	// Format is the signature format, SignatureFormatBLAKE2 if zero.
+	Format SignatureFormat
+	// BlockLen is the length of each block, DefaultSignatureBlockLen if zero.
+	BlockLen int
+	// StrongLen is the number of strong hash bytes stored per block, the
+	// full hash length if zero.
+	StrongLen int
+}
+
+// WriteSignature writes the librsync signature of r to w. The output is
+// identical to that of `rdiff signature` with the rollsum checksum.
+func WriteSignature(w io.Writer, r io.Reader, opts SignatureOptions) error {
+	format := opts.Format
+	if format == 0 {
+		format = SignatureFormatBLAKE2
+	}
+	var newHash func() hash.Hash
+	switch format {
+	case SignatureFormatMD4:
+		newHash = md4.New
+	case SignatureFormatBLAKE2:
+		newHash = func() hash.Hash {
+			h, _ := blake2b.New256(nil)
+			return h
+		}
+	default:
+		return fmt.Errorf("unsupported signature format: %#x", uint32(format))
+	}
+
+	blockLen := opts.BlockLen
+	if blockLen == 0 {
+		blockLen = DefaultSignatureBlockLen
+	}
+	if blockLen < 0 {
+		return errors.New("block length must be positive")
+	}
+	h := newHash()
+	strongLen := opts.StrongLen
+	if strongLen == 0 {
+		strongLen = h.Size()
+	}
+	if strongLen < 0 || strongLen > h.Size() {
+		return fmt.Errorf("strong hash length must be between 1 and %d", h.Size())
+	}
+
+	bw := bufio.NewWriter(w)
+	var header [12]byte
+	binary.BigEndian.PutUint32(header[0:], uint32(format))
+	binary.BigEndian.PutUint32(header[4:], uint32(blockLen))
+	binary.BigEndian.PutUint32(header[8:], uint32(strongLen))
+	if _, err := bw.Write(header[:]); err != nil {
+		return err
+	}
+
+	block := make([]byte, blockLen)
+	var weak [4]byte
+	for {
+		n, err := io.ReadFull(r, block)
+		if n > 0 {
+			binary.BigEndian.PutUint32(weak[:], rollsum(block[:n]))
+			h.Reset()
+			h.Write(block[:n])
+			if _, err := bw.Write(weak[:]); err != nil {
+				return err
+			}
+			if _, err := bw.Write(h.Sum(nil)[:strongLen]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("error reading file: %w", err)
+		}
+	}
+	return bw.Flush()
+}
+
+// rollsum returns the rollsum checksum of block, a variant of Adler-32.
+func rollsum(block []byte) uint32 {
+	var s1, s2 uint32
+	for _, b := range block {
+		s1 += uint32(b)
+		s2 += s1
+	}
+	n := uint64(len(block))
+	s1 += uint32(n * rollsumCharOffset)
+	s2 += uint32(n * (n + 1) / 2 * rollsumCharOffset)
+	return s2<<16 | s1&0xffff
+}
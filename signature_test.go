@@ -0,0 +1,78 @@
+package vrchat
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteSignature(t *testing.T) {
+	// Golden files are named <file>-<hash>-<block length>-<strong length>.signature
+	files, err := filepath.Glob("testdata/librsync/*.signature")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no signature fixtures found")
+	}
+	formats := map[string]SignatureFormat{"md4": SignatureFormatMD4, "blake2": SignatureFormatBLAKE2}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".signature")
+		t.Run(name, func(t *testing.T) {
+			var input, hash string
+			var opts SignatureOptions
+			if _, err := fmt.Sscanf(strings.ReplaceAll(name, "-", " "), "%s %s %d %d", &input, &hash, &opts.BlockLen, &opts.StrongLen); err != nil {
+				t.Fatalf("invalid fixture name: %v", err)
+			}
+			opts.Format = formats[hash]
+
+			old, err := os.ReadFile(filepath.Join("testdata/librsync", input+".old"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got bytes.Buffer
+			if err := WriteSignature(&got, bytes.NewReader(old), opts); err != nil {
+				t.Fatalf("WriteSignature() error = %v", err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("WriteSignature() = %x, want %x", got.Bytes(), want)
+			}
+		})
+	}
+}
+
+func TestWriteSignatureDefaults(t *testing.T) {
+	var got bytes.Buffer
+	if err := WriteSignature(&got, strings.NewReader("hello"), SignatureOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x72, 0x73, 0x01, 0x37, 0, 0, 0x08, 0, 0, 0, 0, 32}
+	if !bytes.HasPrefix(got.Bytes(), want) {
+		t.Errorf("header = %x, want %x", got.Bytes()[:12], want)
+	}
+	if got.Len() != 12+4+32 {
+		t.Errorf("length = %d, want %d", got.Len(), 12+4+32)
+	}
+}
+
+func TestWriteSignatureInvalidOptions(t *testing.T) {
+	for _, opts := range []SignatureOptions{
+		{Format: 0x72730146},
+		{BlockLen: -1},
+		{Format: SignatureFormatMD4, StrongLen: 17},
+		{StrongLen: -1},
+	} {
+		if err := WriteSignature(&bytes.Buffer{}, strings.NewReader("hello"), opts); err == nil {
+			t.Errorf("WriteSignature(%+v) error = nil, want error", opts)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package vrchat
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TOTP generates RFC 6238 time-based one-time passwords, e.g. for bots that
+// log in unattended. It implements CodeProvider.
+type TOTP struct {
+	// Secret is the shared secret.
+	Secret []byte
+	// Digits is the length of a code, 6 if zero. Code rejects more than 9
+	// digits, which a 31-bit truncated HMAC value cannot fill.
+	Digits int
+	// Period is the length of a time step, e.g. 30 * time.Second, and 30
+	// seconds if zero. It is rounded down to whole seconds; Code rejects
+	// periods shorter than a second.
+	Period time.Duration
+	// Hash is the HMAC hash function, SHA-1 if nil.
+	Hash func() hash.Hash
+	// Skew is the number of time steps before and after the current one that
+	// are tried when a code is rejected, to tolerate clock drift. The TOTP
+	// provides 2*Skew+1 codes to LoginWithCodeProvider.
+	Skew int
+
+	// now returns the current time, time.Now if nil.
+	now func() time.Time
+}
+
+// NewTOTP creates a TOTP for the base32 encoded secret shown when setting up
+// an authenticator app. It tolerates a skew of one time step.
+func NewTOTP(secret string) (*TOTP, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return &TOTP{Secret: key, Skew: 1}, nil
+}
+
+// GenerateTOTP returns the code for the base32 encoded secret at time t.
+func GenerateTOTP(secret string, t time.Time) (string, error) {
+	totp, err := NewTOTP(secret)
+	if err != nil {
+		return "", err
+	}
+	return totp.Generate(t), nil
+}
+
+// Generate returns the code valid at the given time.
+func (t *TOTP) Generate(at time.Time) string {
+	return t.generate(uint64(at.Unix() / int64(t.period()/time.Second)))
+}
+
+// period returns the time step in whole seconds, at least one second.
+func (t *TOTP) period() time.Duration {
+	if t.Period <= 0 {
+		return 30 * time.Second
+	}
+	return max(t.Period.Truncate(time.Second), time.Second)
+}
+
+// validate returns an error for settings that cannot generate valid codes.
+func (t *TOTP) validate() error {
+	if t.Period > 0 && t.Period < time.Second {
+		return fmt.Errorf("TOTP period %v is shorter than a second", t.Period)
+	}
+	if t.Digits > 9 {
+		return fmt.Errorf("TOTP codes of %d digits are not supported, want at most 9", t.Digits)
+	}
+	return nil
+}
+
+// MaxAttempts implements CodeAttempts.
+func (t *TOTP) MaxAttempts() int {
+	return 2*max(t.Skew, 0) + 1
+}
+
+func (t *TOTP) generate(counter uint64) string {
+	digits := t.Digits
+	if digits <= 0 {
+		digits = 6
+	}
+	hashFunc := t.Hash
+	if hashFunc == nil {
+		hashFunc = sha1.New
+	}
+
+	mac := hmac.New(hashFunc, t.Secret)
+	binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation as described in RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:]) & 0x7fffffff
+
+	mod := uint64(1)
+	for range min(digits, 10) {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, uint64(value)%mod)
+}
+
+// Code implements CodeProvider. The first attempt uses the current time step,
+// later attempts walk through the steps within Skew: previous, next, and so on.
+func (t *TOTP) Code(ctx context.Context, req CodeRequest) (TwoFactorMethod, string, error) {
+	if !slices.Contains(req.Methods, TwoFactorTOTP) {
+		return "", "", fmt.Errorf("TOTP not accepted, want one of %v", req.Methods)
+	}
+	if err := t.validate(); err != nil {
+		return "", "", err
+	}
+	if req.Attempt >= t.MaxAttempts() {
+		return "", "", ErrInvalidCode
+	}
+
+	// Attempts 0, 1, 2, 3, 4, ... map to step offsets 0, -1, +1, -2, +2, ...
+	offset := (req.Attempt + 1) / 2
+	if req.Attempt%2 == 1 {
+		offset = -offset
+	}
+
+	now := time.Now
+	if t.now != nil {
+		now = t.now
+	}
+	return TwoFactorTOTP, t.Generate(now().Add(time.Duration(offset) * t.period())), nil
+}
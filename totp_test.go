@@ -0,0 +1,139 @@
+package vrchat
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"hash"
+	"testing"
+	"time"
+)
+
+// Test vectors from RFC 6238 appendix B
+func TestTOTPGenerate(t *testing.T) {
+	secrets := map[string]struct {
+		secret string
+		hash   func() hash.Hash
+	}{
+		"SHA1":   {"12345678901234567890", sha1.New},
+		"SHA256": {"12345678901234567890123456789012", sha256.New},
+		"SHA512": {"1234567890123456789012345678901234567890123456789012345678901234", sha512.New},
+	}
+	tests := []struct {
+		unix int64
+		mode string
+		want string
+	}{
+		{59, "SHA1", "94287082"},
+		{59, "SHA256", "46119246"},
+		{59, "SHA512", "90693936"},
+		{1111111109, "SHA1", "07081804"},
+		{1111111109, "SHA256", "68084774"},
+		{1111111109, "SHA512", "25091201"},
+		{1111111111, "SHA1", "14050471"},
+		{1111111111, "SHA256", "67062674"},
+		{1111111111, "SHA512", "99943326"},
+		{1234567890, "SHA1", "89005924"},
+		{1234567890, "SHA256", "91819424"},
+		{1234567890, "SHA512", "93441116"},
+		{2000000000, "SHA1", "69279037"},
+		{2000000000, "SHA256", "90698825"},
+		{2000000000, "SHA512", "38618901"},
+		{20000000000, "SHA1", "65353130"},
+		{20000000000, "SHA256", "77737706"},
+		{20000000000, "SHA512", "47863826"},
+	}
+	for _, tt := range tests {
+		s := secrets[tt.mode]
+		totp := &TOTP{Secret: []byte(s.secret), Digits: 8, Hash: s.hash}
+		if got := totp.Generate(time.Unix(tt.unix, 0)); got != tt.want {
+			t.Errorf("%s at %d = %s, want %s", tt.mode, tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateTOTPBase32(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	got, err := GenerateTOTP(secret, time.Unix(59, 0))
+	if err != nil {
+		t.Fatalf("GenerateTOTP: %v", err)
+	}
+	if want := "287082"; got != want {
+		t.Errorf("GenerateTOTP = %s, want %s", got, want)
+	}
+
+	if _, err := GenerateTOTP("not base32!", time.Now()); err == nil {
+		t.Error("GenerateTOTP with invalid secret succeeded, want error")
+	}
+}
+
+func TestTOTPCodeProviderSkew(t *testing.T) {
+	totp, err := NewTOTP("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	if err != nil {
+		t.Fatalf("NewTOTP: %v", err)
+	}
+	now := time.Unix(1111111111, 0)
+	totp.now = func() time.Time { return now }
+
+	want := []string{
+		totp.Generate(now),
+		totp.Generate(now.Add(-30 * time.Second)),
+		totp.Generate(now.Add(30 * time.Second)),
+	}
+	methods := []TwoFactorMethod{TwoFactorTOTP, TwoFactorOTP}
+	for attempt, code := range want {
+		method, got, err := totp.Code(context.Background(), CodeRequest{Methods: methods, Attempt: attempt})
+		if err != nil {
+			t.Fatalf("attempt %d: %v", attempt, err)
+		}
+		if method != TwoFactorTOTP || got != code {
+			t.Errorf("attempt %d = %s %s, want %s %s", attempt, method, got, TwoFactorTOTP, code)
+		}
+	}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: methods, Attempt: len(want)}); err != ErrInvalidCode {
+		t.Errorf("attempt %d error = %v, want ErrInvalidCode", len(want), err)
+	}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: []TwoFactorMethod{TwoFactorEmailOTP}}); err == nil {
+		t.Error("Code for emailOtp account succeeded, want error")
+	}
+}
+
+func TestTOTPPeriod(t *testing.T) {
+	totp := &TOTP{Secret: []byte("12345678901234567890"), Period: 500 * time.Millisecond}
+	now := time.Unix(59, 0)
+	if got, want := totp.Generate(now), (&TOTP{Secret: totp.Secret, Period: time.Second}).Generate(now); got != want {
+		t.Errorf("Generate with 500ms period = %s, want %s", got, want)
+	}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: []TwoFactorMethod{TwoFactorTOTP}}); err == nil {
+		t.Error("Code with 500ms period succeeded, want error")
+	}
+}
+
+func TestTOTPMaxAttempts(t *testing.T) {
+	totp := &TOTP{Secret: []byte("12345678901234567890"), Skew: 2}
+	if got := totp.MaxAttempts(); got != 5 {
+		t.Fatalf("MaxAttempts = %d, want 5", got)
+	}
+	methods := []TwoFactorMethod{TwoFactorTOTP}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: methods, Attempt: 4}); err != nil {
+		t.Errorf("attempt 4: %v", err)
+	}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: methods, Attempt: 5}); err != ErrInvalidCode {
+		t.Errorf("attempt 5 error = %v, want ErrInvalidCode", err)
+	}
+}
+
+func TestTOTPDigits(t *testing.T) {
+	totp := &TOTP{Secret: []byte("12345678901234567890"), Digits: 10}
+	if _, _, err := totp.Code(context.Background(), CodeRequest{Methods: []TwoFactorMethod{TwoFactorTOTP}}); err == nil {
+		t.Error("Code with 10 digits succeeded, want error")
+	}
+
+	// RFC 4226 appendix D: the first truncated value is 1284755224
+	totp.Digits = 9
+	if got, want := totp.generate(0), "284755224"; got != want {
+		t.Errorf("generate(0) with 9 digits = %s, want %s", got, want)
+	}
+}
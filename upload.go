@@ -0,0 +1,426 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// File data types of a file version, used as the fileType of the file data endpoints.
+const (
+	FileDataTypeFile      = "file"
+	FileDataTypeSignature = "signature"
+	FileDataTypeDelta     = "delta"
+)
+
+// Upload categories of FileData.
+const (
+	FileDataCategorySimple    = "simple"
+	FileDataCategoryMultipart = "multipart"
+	FileDataCategoryQueued    = "queued"
+)
+
+// DefaultPartSize is the size of each part of a multipart upload unless overridden by UploadOptions.PartSize.
+const DefaultPartSize = 10 << 20
+
+// DefaultUploadConcurrency is the number of parts uploaded in parallel unless
+// overridden by UploadOptions.Concurrency.
+const DefaultUploadConcurrency = 4
+
+// UploadOptions describes the file created by UploadFile.
+type UploadOptions struct {
+	// FileId adds a new version to an existing file instead of creating a new file.
+	// If the latest version of the file is an unfinished upload of the same
+	// data, the upload is resumed instead.
+	FileId FileId
+	// Name, Extension, MimeType and Tags describe the new file. They are ignored if FileId is set.
+	Name      string
+	Extension string
+	MimeType  MimeType
+	Tags      []Tag
+	// Signature is the librsync signature of the file. It is generated with
+	// WriteSignature and the default SignatureOptions if nil.
+	Signature io.Reader
+	// PartSize is the size of each part of a multipart upload, DefaultPartSize
+	// if zero. A resumed upload must use the same part size.
+	PartSize int64
+	// Concurrency is the number of parts uploaded in parallel, DefaultUploadConcurrency if zero.
+	Concurrency int
+	// PartRetryPolicy configures the retries of each part, DefaultRetryPolicy if nil.
+	PartRetryPolicy *RetryPolicy
+}
+
+// UploadError is returned by UploadFile when the upload fails after the file
+// version was created. Calling UploadFile again with FileId set resumes it.
+type UploadError struct {
+	FileId    FileId
+	VersionId int64
+	Err       error
+}
+
+func (e *UploadError) Error() string {
+	return fmt.Sprintf("error uploading version %d of %s: %v", e.VersionId, e.FileId, e.Err)
+}
+
+func (e *UploadError) Unwrap() error {
+	return e.Err
+}
+
+// uploadSource is file data that can be read at any offset, so parts can be sent independently.
+type uploadSource struct {
+	r     io.ReaderAt
+	size  int64
+	md5   string
+	close func() error
+}
+
+// newUploadSource computes the size and MD5 of the data from the current offset
+// of r to its end, and leaves r at its end. Readers that cannot seek are copied
+// to a temporary file first.
+func newUploadSource(r io.Reader) (*uploadSource, error) {
+	src := &uploadSource{close: func() error { return nil }}
+	h := md5.New()
+
+	ra, ok := r.(io.ReaderAt)
+	seeker, canSeek := r.(io.Seeker)
+	if ok && canSeek {
+		start, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, fmt.Errorf("error seeking file: %w", err)
+		}
+		end, err := seeker.Seek(0, io.SeekEnd)
+		if err != nil {
+			return nil, fmt.Errorf("error seeking file: %w", err)
+		}
+		data := io.NewSectionReader(ra, start, end-start)
+		if _, err := io.Copy(h, data); err != nil {
+			return nil, fmt.Errorf("error reading file: %w", err)
+		}
+		src.r, src.size = data, data.Size()
+	} else {
+		f, err := os.CreateTemp("", "vrchat-upload-*")
+		if err != nil {
+			return nil, fmt.Errorf("error creating temporary file: %w", err)
+		}
+		src.close = func() error {
+			f.Close()
+			return os.Remove(f.Name())
+		}
+		size, err := io.Copy(io.MultiWriter(f, h), r)
+		if err != nil {
+			src.close()
+			return nil, fmt.Errorf("error reading file: %w", err)
+		}
+		src.r, src.size = f, size
+	}
+
+	src.md5 = base64.StdEncoding.EncodeToString(h.Sum(nil))
+	return src, nil
+}
+
+// UploadFile uploads the data of r as a new file version and returns the file.
+// It creates the file and version, uploads the file and its signature in one
+// request or in parts as the API requests, and finishes the upload. The data
+// is read from the current offset of r, like io.Copy would.
+func (c *Client) UploadFile(ctx context.Context, r io.Reader, opts UploadOptions) (*File, error) {
+	src, err := newUploadSource(r)
+	if err != nil {
+		return nil, err
+	}
+	defer src.close()
+
+	var signature bytes.Buffer
+	if opts.Signature != nil {
+		_, err = io.Copy(&signature, opts.Signature)
+	} else {
+		err = WriteSignature(&signature, io.NewSectionReader(src.r, 0, src.size), SignatureOptions{})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading signature: %w", err)
+	}
+	sigSrc, err := newUploadSource(bytes.NewReader(signature.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	fileId := opts.FileId
+	var file *FileResponse
+	resume := false
+	if fileId == "" {
+		req := CreateFileRequest{
+			Name:      opts.Name,
+			Extension: opts.Extension,
+			MimeType:  opts.MimeType,
+		}
+		if len(opts.Tags) > 0 {
+			req.Tags = Ptr(opts.Tags)
+		}
+		file, err = c.CreateFileWithContext(ctx, req)
+		if err != nil {
+			return nil, fmt.Errorf("error creating file: %w", err)
+		}
+		fileId = file.Id
+	} else {
+		file, err = c.GetFileWithContext(ctx, GetFileParams{FileId: string(fileId)})
+		if err != nil {
+			return nil, fmt.Errorf("error getting file: %w", err)
+		}
+		if n := len(file.Versions); n > 0 {
+			latest := file.Versions[n-1]
+			resume = latest.Status == FileStatusWaiting && latest.File.Md5 == src.md5 && latest.File.SizeInBytes == src.size
+		}
+	}
+
+	if !resume {
+		file, err = c.CreateFileVersionWithContext(ctx, CreateFileVersionParams{FileId: string(fileId)}, CreateFileVersionRequest{
+			FileMd5:              Ptr(src.md5),
+			FileSizeInBytes:      Ptr(float64(src.size)),
+			SignatureMd5:         sigSrc.md5,
+			SignatureSizeInBytes: float64(sigSrc.size),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating file version: %w", err)
+		}
+	}
+	if len(file.Versions) == 0 {
+		return nil, errors.New("file has no versions")
+	}
+	version := file.Versions[len(file.Versions)-1]
+
+	u := uploader{
+		client:      c,
+		fileId:      string(fileId),
+		versionId:   version.Version,
+		partSize:    opts.PartSize,
+		concurrency: opts.Concurrency,
+		retryPolicy: DefaultRetryPolicy(),
+	}
+	if u.partSize <= 0 {
+		u.partSize = DefaultPartSize
+	}
+	if u.concurrency <= 0 {
+		u.concurrency = DefaultUploadConcurrency
+	}
+	if opts.PartRetryPolicy != nil {
+		u.retryPolicy = *opts.PartRetryPolicy
+	}
+
+	uploads := []struct {
+		fileType string
+		data     FileData
+		src      *uploadSource
+		mimeType MimeType
+	}{
+		{FileDataTypeFile, version.File, src, file.MimeType},
+		{FileDataTypeSignature, version.Signature, sigSrc, MimeTypeApplicationXRsyncSignature},
+	}
+	for _, data := range uploads {
+		// Data of a resumed version may already be complete
+		if data.data.Status == FileStatusComplete {
+			continue
+		}
+		file, err = u.upload(ctx, data.fileType, data.data.Category, data.src, data.mimeType, resume)
+		if err != nil {
+			return nil, &UploadError{
+				FileId:    fileId,
+				VersionId: version.Version,
+				Err:       fmt.Errorf("error uploading %s: %w", data.fileType, err),
+			}
+		}
+	}
+	return (*File)(file), nil
+}
+
+// uploader uploads the file data of a file version.
+type uploader struct {
+	client      *Client
+	fileId      string
+	versionId   int64
+	partSize    int64
+	concurrency int
+	retryPolicy RetryPolicy
+}
+
+// upload uploads src as the fileType data of the file version and finishes the upload.
+// When resume is set, the parts reported by GetFileDataUploadStatus are skipped.
+func (u *uploader) upload(ctx context.Context, fileType string, category string, src *uploadSource, mimeType MimeType, resume bool) (*FileResponse, error) {
+	finish := FinishFileDataUploadRequest{MaxParts: "0", NextPartNumber: "0"}
+
+	if category == FileDataCategoryMultipart {
+		var done map[int64]string
+		if resume {
+			status, err := u.client.GetFileDataUploadStatusWithContext(ctx, GetFileDataUploadStatusParams{
+				FileId:    u.fileId,
+				VersionId: u.versionId,
+				FileType:  fileType,
+			})
+			// The status is unavailable if no part was started, so the upload starts over
+			if err == nil {
+				done = uploadedParts(status)
+			}
+		}
+		etags, err := u.uploadParts(ctx, fileType, src, done)
+		if err != nil {
+			return nil, err
+		}
+		finish.Etags = Ptr(etags)
+	} else {
+		header := http.Header{}
+		header.Set("Content-MD5", src.md5)
+		header.Set("Content-Type", string(mimeType))
+		if _, err := u.uploadPart(ctx, fileType, nil, io.NewSectionReader(src.r, 0, src.size), header); err != nil {
+			return nil, err
+		}
+	}
+
+	return u.client.FinishFileDataUploadWithContext(ctx, FinishFileDataUploadParams{
+		FileId:    u.fileId,
+		VersionId: u.versionId,
+		FileType:  fileType,
+	}, finish)
+}
+
+// uploadParts uploads the parts of src that are not in done in parallel and
+// returns the ETags of all parts.
+func (u *uploader) uploadParts(ctx context.Context, fileType string, src *uploadSource, done map[int64]string) ([]string, error) {
+	parts := (src.size + u.partSize - 1) / u.partSize
+	etags := make([]string, parts)
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	jobs := make(chan int64)
+	var wg sync.WaitGroup
+	for range min(int64(u.concurrency), parts) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for part := range jobs {
+				offset := (part - 1) * u.partSize
+				body := io.NewSectionReader(src.r, offset, min(u.partSize, src.size-offset))
+				etag, err := u.uploadPart(ctx, fileType, Ptr(part), body, nil)
+				if err != nil {
+					cancel(fmt.Errorf("error uploading part %d: %w", part, err))
+					return
+				}
+				etags[part-1] = etag
+			}
+		}()
+	}
+
+feed:
+	for part := int64(1); part <= parts; part++ {
+		if etag, ok := done[part]; ok {
+			etags[part-1] = etag
+			continue
+		}
+		select {
+		case jobs <- part:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return nil, err
+	}
+	return etags, nil
+}
+
+// uploadPart starts the upload of a part, or of the whole data if part is nil,
+// and sends body to the returned URL. Failed attempts are retried according to
+// the retry policy of the uploader.
+func (u *uploader) uploadPart(ctx context.Context, fileType string, part *int64, body *io.SectionReader, header http.Header) (string, error) {
+	for attempt := 0; ; attempt++ {
+		upload, err := u.client.StartFileDataUploadWithContext(ctx, StartFileDataUploadParams{
+			FileId:     u.fileId,
+			VersionId:  u.versionId,
+			FileType:   fileType,
+			PartNumber: part,
+		})
+		var etag string
+		if err == nil {
+			etag, err = u.client.putFileData(ctx, upload.Url, body, body.Size(), header)
+			if err == nil {
+				return etag, nil
+			}
+		}
+
+		// API errors other than the retryable status codes will fail again
+		var apiErr *APIError
+		if attempt >= u.retryPolicy.MaxRetries || ctx.Err() != nil ||
+			errors.As(err, &apiErr) && !slices.Contains(u.retryPolicy.StatusCodes, apiErr.StatusCode) {
+			return "", err
+		}
+		select {
+		case <-time.After(u.retryPolicy.backoff(attempt)):
+		case <-ctx.Done():
+			return "", context.Cause(ctx)
+		}
+	}
+}
+
+// uploadedParts returns the ETags of the parts reported as uploaded by status,
+// keyed by part number.
+func uploadedParts(status *FileVersionUploadStatusResponse) map[int64]string {
+	done := make(map[int64]string)
+	for i, etag := range status.Etags {
+		if s, ok := etag.(string); ok && s != "" {
+			done[int64(i+1)] = s
+		}
+	}
+	// Parts may also be listed as S3 part objects
+	for _, part := range status.Parts {
+		p, ok := part.(map[string]any)
+		if !ok {
+			continue
+		}
+		number, _ := p["PartNumber"].(float64)
+		etag, _ := p["ETag"].(string)
+		if number > 0 && etag != "" {
+			done[int64(number)] = etag
+		}
+	}
+	return done
+}
+
+// putFileData sends body to the upload URL returned by StartFileDataUpload and returns the ETag of the upload.
+func (c *Client) putFileData(ctx context.Context, url string, body *io.SectionReader, size int64, header http.Header) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, url, io.NewSectionReader(body, 0, size))
+	if err != nil {
+		return "", fmt.Errorf("error creating request: %w", err)
+	}
+	req.ContentLength = size
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(io.NewSectionReader(body, 0, size)), nil
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+
+	// The timeout of the client would include sending the whole body, which
+	// may take longer for large parts, so only the deadline of ctx applies
+	hc := *c.client.GetClient()
+	hc.Timeout = 0
+	resp, err := hc.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error sending request: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("unexpected status code: %d, message: %s", resp.StatusCode, msg)
+	}
+	return resp.Header.Get("ETag"), nil
+}
@@ -0,0 +1,173 @@
+package vrchat
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// uploadServer is a file API with a multipart file upload whose second part
+// fails until failPart is cleared.
+type uploadServer struct {
+	t   *testing.T
+	url string
+
+	mu       sync.Mutex
+	md5      string
+	size     int64
+	failPart bool
+	parts    map[string][]byte
+	finished map[string]FinishFileDataUploadRequest
+}
+
+func (s *uploadServer) file() File {
+	return File{
+		Id:       "file_1",
+		MimeType: MimeTypeApplicationXAvatar,
+		Versions: []FileVersion{
+			{Version: 0, Status: FileStatusComplete},
+			{
+				Version:   1,
+				Status:    FileStatusWaiting,
+				File:      FileData{Category: FileDataCategoryMultipart, Md5: s.md5, SizeInBytes: s.size, Status: FileStatusWaiting},
+				Signature: FileData{Category: FileDataCategorySimple, Status: FileStatusWaiting},
+			},
+		},
+	}
+}
+
+func (s *uploadServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	body, _ := io.ReadAll(r.Body)
+
+	var response any
+	switch path := r.Method + " " + r.URL.Path; path {
+	case "POST /file":
+		response = File{Id: "file_1", MimeType: MimeTypeApplicationXAvatar, Versions: []FileVersion{{Version: 0}}}
+	case "POST /file/file_1":
+		var req CreateFileVersionRequest
+		json.Unmarshal(body, &req)
+		s.md5, s.size = *req.FileMd5, int64(*req.FileSizeInBytes)
+		response = s.file()
+	case "GET /file/file_1":
+		response = s.file()
+	case "PUT /file/file_1/1/file/start":
+		response = FileUploadUrl{Url: s.url + "/s3/file/" + r.URL.Query().Get("partNumber")}
+	case "PUT /file/file_1/1/signature/start":
+		response = FileUploadUrl{Url: s.url + "/s3/signature"}
+	case "GET /file/file_1/1/file/status":
+		response = map[string]any{
+			"etags": []string{"etag-1"},
+			"parts": []map[string]any{{"PartNumber": 3, "ETag": "etag-3"}},
+		}
+	case "PUT /file/file_1/1/file/finish", "PUT /file/file_1/1/signature/finish":
+		var req FinishFileDataUploadRequest
+		json.Unmarshal(body, &req)
+		s.finished[path] = req
+		response = s.file()
+	case "PUT /s3/file/2":
+		if s.failPart {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fallthrough
+	case "PUT /s3/file/1", "PUT /s3/file/3", "PUT /s3/signature":
+		s.parts[r.URL.Path] = body
+		w.Header().Set("ETag", "etag-"+r.URL.Path[len(r.URL.Path)-1:])
+		return
+	default:
+		s.t.Errorf("unexpected request %s", path)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func TestUploadFileResume(t *testing.T) {
+	s := &uploadServer{t: t, failPart: true, parts: map[string][]byte{}, finished: map[string]FinishFileDataUploadRequest{}}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	s.url = srv.URL
+	client := NewClient(srv.URL)
+
+	// The data starts at the current offset of the reader
+	content := []byte("header:0123456789abcdefghijklmnopqrst")
+	data := content[7:]
+	r := bytes.NewReader(content)
+	r.Seek(7, io.SeekStart)
+	opts := UploadOptions{
+		Name:            "Avatar",
+		Extension:       ".vrca",
+		MimeType:        MimeTypeApplicationXAvatar,
+		Signature:       bytes.NewReader([]byte("signature")),
+		PartSize:        10,
+		Concurrency:     1,
+		PartRetryPolicy: &RetryPolicy{},
+	}
+
+	_, err := client.UploadFile(context.Background(), r, opts)
+	var uploadErr *UploadError
+	if !errors.As(err, &uploadErr) {
+		t.Fatalf("UploadFile() error = %v, want *UploadError", err)
+	}
+	if uploadErr.FileId != "file_1" || uploadErr.VersionId != 1 {
+		t.Errorf("UploadError = %s %d, want file_1 1", uploadErr.FileId, uploadErr.VersionId)
+	}
+	sum := md5.Sum(data)
+	if want := base64.StdEncoding.EncodeToString(sum[:]); s.md5 != want || s.size != int64(len(data)) {
+		t.Errorf("version MD5 and size = %s %d, want %s %d", s.md5, s.size, want, len(data))
+	}
+
+	// The status reports parts 1 and 3, so only part 2 is uploaded again
+	s.failPart = false
+	s.parts = map[string][]byte{}
+	r.Seek(7, io.SeekStart)
+	opts.FileId = uploadErr.FileId
+	opts.Signature = bytes.NewReader([]byte("signature"))
+	if _, err := client.UploadFile(context.Background(), r, opts); err != nil {
+		t.Fatalf("resumed UploadFile() error = %v", err)
+	}
+
+	wantParts := map[string][]byte{"/s3/file/2": data[10:20], "/s3/signature": []byte("signature")}
+	if !reflect.DeepEqual(s.parts, wantParts) {
+		t.Errorf("uploaded = %q, want %q", s.parts, wantParts)
+	}
+	finish := s.finished["PUT /file/file_1/1/file/finish"]
+	if want := []string{"etag-1", "etag-2", "etag-3"}; finish.Etags == nil || !reflect.DeepEqual(*finish.Etags, want) {
+		t.Errorf("finish ETags = %v, want %v", finish.Etags, want)
+	}
+	if _, ok := s.finished["PUT /file/file_1/1/signature/finish"]; !ok {
+		t.Error("signature upload was not finished")
+	}
+	if n, _ := r.Seek(0, io.SeekCurrent); n != int64(len(content)) {
+		t.Errorf("reader offset = %d, want %d", n, len(content))
+	}
+}
+
+func TestPutFileDataIgnoresClientTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		io.Copy(io.Discard, r.Body)
+		w.Header().Set("ETag", "etag-1")
+	}))
+	t.Cleanup(srv.Close)
+	client := NewClient(srv.URL, WithTimeout(20*time.Millisecond))
+
+	data := []byte("data")
+	etag, err := client.putFileData(context.Background(), srv.URL, io.NewSectionReader(bytes.NewReader(data), 0, int64(len(data))), int64(len(data)), nil)
+	if err != nil || etag != "etag-1" {
+		t.Errorf("putFileData() = %q, %v, want etag-1", etag, err)
+	}
+}
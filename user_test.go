@@ -0,0 +1,48 @@
+package vrchat
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestUpdateUserRequestBody(t *testing.T) {
+	runRequestTests(t, []requestTest{
+		{
+			name: "status and empty bio",
+			call: func(c *Client) error {
+				_, err := c.UpdateUser(UpdateUserParams{UserId: "usr_1"}, UpdateUserRequest{
+					Status:            Ptr(UserStatusBusy),
+					StatusDescription: Ptr("streaming"),
+					Bio:               Ptr(""),
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/users/usr_1",
+			body:   `{"status":"busy","statusDescription":"streaming","bio":""}`,
+		},
+		{
+			name: "cleared bio links and booping disabled",
+			call: func(c *Client) error {
+				_, err := c.UpdateUser(UpdateUserParams{UserId: "usr_1"}, UpdateUserRequest{
+					BioLinks:         Ptr([]string{}),
+					IsBoopingEnabled: Ptr(false),
+				})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/users/usr_1",
+			body:   `{"bioLinks":[],"isBoopingEnabled":false}`,
+		},
+		{
+			name: "nothing set",
+			call: func(c *Client) error {
+				_, err := c.UpdateUser(UpdateUserParams{UserId: "usr_1"}, UpdateUserRequest{})
+				return err
+			},
+			method: http.MethodPut,
+			path:   "/users/usr_1",
+			body:   `{}`,
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package vrchat
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCannotPublish is returned by PublishWorldFlow when the API does not allow
+// the world to be published, e.g. because it is already published or the
+// Community Labs limit is reached.
+var ErrCannotPublish = errors.New("world cannot be published")
+
+// PublishWorldFlow creates or updates a world and publishes it to Community Labs.
+type PublishWorldFlow struct {
+	Client *Client
+	// WorldId is the world to update and publish. It is ignored if Create is set.
+	WorldId string
+	// Create creates a new world to publish.
+	Create *CreateWorldRequest
+	// Update updates the world before publishing it, after creating it if
+	// Create is set.
+	Update *UpdateWorldRequest
+	// PollInterval is the interval of publish status checks, 5 seconds if zero.
+	PollInterval time.Duration
+	// PollTimeout is how long to wait for the publish to take effect, 5
+	// minutes if zero.
+	PollTimeout time.Duration
+}
+
+// Run runs the flow and returns the published world. After PublishWorld it
+// polls GetWorldPublishStatus until the world can no longer be published,
+// which means the publish has taken effect, or until PollTimeout has passed.
+func (f *PublishWorldFlow) Run(ctx context.Context) (*World, error) {
+	worldId := f.WorldId
+	if f.Create != nil {
+		world, err := f.Client.CreateWorldWithContext(ctx, *f.Create)
+		if err != nil {
+			return nil, fmt.Errorf("error creating world: %w", err)
+		}
+		worldId = string(world.Id)
+	}
+	if worldId == "" {
+		return nil, errors.New("world ID is required unless a world is created")
+	}
+	if f.Update != nil {
+		if _, err := f.Client.UpdateWorldWithContext(ctx, UpdateWorldParams{WorldId: worldId}, *f.Update); err != nil {
+			return nil, fmt.Errorf("error updating world: %w", err)
+		}
+	}
+
+	status, err := f.Client.GetWorldPublishStatusWithContext(ctx, GetWorldPublishStatusParams{WorldId: worldId})
+	if err != nil {
+		return nil, fmt.Errorf("error getting publish status: %w", err)
+	}
+	if !status.CanPublish {
+		return nil, fmt.Errorf("%w: %s", ErrCannotPublish, worldId)
+	}
+	if err := f.Client.PublishWorldWithContext(ctx, PublishWorldParams{WorldId: worldId}); err != nil {
+		return nil, fmt.Errorf("error publishing world: %w", err)
+	}
+
+	if err := f.wait(ctx, worldId); err != nil {
+		return nil, err
+	}
+
+	world, err := f.Client.GetWorldWithContext(ctx, GetWorldParams{WorldId: worldId})
+	if err != nil {
+		return nil, err
+	}
+	return (*World)(world), nil
+}
+
+// wait polls the publish status of the world until it can no longer be published.
+func (f *PublishWorldFlow) wait(ctx context.Context, worldId string) error {
+	interval := f.PollInterval
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	timeout := f.PollTimeout
+	if timeout <= 0 {
+		timeout = 5 * time.Minute
+	}
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		status, err := f.Client.GetWorldPublishStatusWithContext(ctx, GetWorldPublishStatusParams{WorldId: worldId})
+		if err != nil {
+			return fmt.Errorf("error getting publish status: %w", err)
+		}
+		if !status.CanPublish {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return fmt.Errorf("world %s was not published after %v", worldId, timeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package vrchat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newPublishServer returns a client for a server with the world wrld_1, which
+// reports canPublish until it has been polled pending times after publishing.
+func newPublishServer(t *testing.T, pending int) (*Client, func() []string) {
+	t.Helper()
+	var mu sync.Mutex
+	var requests []string
+	published := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		request := r.Method + " " + r.URL.Path
+		if data, _ := io.ReadAll(r.Body); len(data) > 0 {
+			request += " " + string(data)
+		}
+		requests = append(requests, request)
+
+		var response any
+		switch r.Method + " " + r.URL.Path {
+		case "POST /worlds", "PUT /worlds/wrld_1", "GET /worlds/wrld_1":
+			response = World{Id: "wrld_1", Name: "My World"}
+		case "GET /worlds/wrld_1/publish":
+			if published {
+				pending--
+			}
+			response = WorldPublishStatus{CanPublish: !published || pending >= 0}
+		case "PUT /worlds/wrld_1/publish":
+			published = true
+			response = map[string]any{}
+		default:
+			t.Errorf("unexpected request %s", request)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(srv.Close)
+	return NewClient(srv.URL), func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestPublishWorldFlow(t *testing.T) {
+	client, requests := newPublishServer(t, 2)
+	flow := PublishWorldFlow{
+		Client:       client,
+		Create:       &CreateWorldRequest{Name: "My World", AssetUrl: "https://example.com/world", ImageUrl: "https://example.com/image.png"},
+		Update:       &UpdateWorldRequest{Capacity: Ptr[int64](16)},
+		PollInterval: time.Millisecond,
+	}
+
+	world, err := flow.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if world.Id != "wrld_1" {
+		t.Errorf("world = %s, want wrld_1", world.Id)
+	}
+
+	want := []string{
+		`POST /worlds {"assetUrl":"https://example.com/world","imageUrl":"https://example.com/image.png","name":"My World"}`,
+		`PUT /worlds/wrld_1 {"capacity":16}`,
+		"GET /worlds/wrld_1/publish",
+		"PUT /worlds/wrld_1/publish",
+		"GET /worlds/wrld_1/publish",
+		"GET /worlds/wrld_1/publish",
+		"GET /worlds/wrld_1/publish",
+		"GET /worlds/wrld_1",
+	}
+	if got := requests(); !reflect.DeepEqual(got, want) {
+		t.Errorf("requests =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestPublishWorldFlowTimeout(t *testing.T) {
+	client, _ := newPublishServer(t, 1<<30)
+	flow := PublishWorldFlow{
+		Client:       client,
+		WorldId:      "wrld_1",
+		PollInterval: time.Millisecond,
+		PollTimeout:  20 * time.Millisecond,
+	}
+	if _, err := flow.Run(context.Background()); err == nil {
+		t.Error("Run() succeeded, want timeout error")
+	}
+}
+
+func TestPublishWorldFlowRequiresWorldId(t *testing.T) {
+	client, requests := newPublishServer(t, 0)
+	flow := PublishWorldFlow{Client: client, Update: &UpdateWorldRequest{Name: Ptr("My World")}}
+	if _, err := flow.Run(context.Background()); err == nil {
+		t.Error("Run() without world ID succeeded, want error")
+	}
+	if got := requests(); len(got) != 0 {
+		t.Errorf("sent %q, want no requests", got)
+	}
+
+	// A world that is already published cannot be published again
+	flow = PublishWorldFlow{Client: client, WorldId: "wrld_1"}
+	if _, err := flow.Run(context.Background()); err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if _, err := flow.Run(context.Background()); !errors.Is(err, ErrCannotPublish) {
+		t.Errorf("Run() of published world error = %v, want ErrCannotPublish", err)
+	}
+}